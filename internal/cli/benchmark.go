@@ -0,0 +1,293 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openjny/council/internal/config"
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchQuestions  string
+	benchReferences string
+	benchConfigs    [2]string
+)
+
+var benchmarkCmd = &cobra.Command{
+	Use:   "benchmark",
+	Short: "Compare two council configurations over a set of questions",
+	Long: `Run two council configurations over the same questions, one question at a
+time, and compare their average latency, model requests (the cost of a
+question), reviewer agreement and, given reference answers, accuracy.
+
+Each configuration is a profile name or an inline JSON object with the
+fields of a profile, as for --config-json.`,
+	Example: `  copilot-council benchmark --questions q.txt --config-a fast --config-b thorough
+  copilot-council benchmark --questions q.txt --references answers.txt \
+    --config-a '{"models": ["gpt-5.2", "claude-sonnet-4.5"]}' --config-b '{"mode": "roundtable"}'`,
+	Args: cobra.NoArgs,
+	RunE: runBenchmark,
+}
+
+func init() {
+	benchmarkCmd.Flags().StringVar(&benchQuestions, "questions", "",
+		"File with one question per line (blank lines and lines starting with # are ignored)")
+	benchmarkCmd.Flags().StringVar(&benchReferences, "references", "",
+		"File with the reference answer to each question, one per line in the same order; an answer is correct when it contains its reference")
+	benchmarkCmd.Flags().StringVar(&benchConfigs[0], "config-a", "",
+		"First configuration: a profile name or an inline JSON object with the fields of a profile")
+	benchmarkCmd.Flags().StringVar(&benchConfigs[1], "config-b", "",
+		"Second configuration: a profile name or an inline JSON object with the fields of a profile")
+	benchmarkCmd.Flags().BoolVar(&mock, "mock", os.Getenv("COPILOT_COUNCIL_MOCK") == "1",
+		"Use a deterministic offline model backend (env: COPILOT_COUNCIL_MOCK=1)")
+	benchmarkCmd.Flags().StringVar(&mockFixture, "mock-fixture", os.Getenv("COPILOT_COUNCIL_MOCK_FIXTURE"),
+		"JSON fixture scripting mock responses (implies --mock)")
+	_ = benchmarkCmd.MarkFlagRequired("questions")
+	_ = benchmarkCmd.MarkFlagRequired("config-a")
+	_ = benchmarkCmd.MarkFlagRequired("config-b")
+	_ = benchmarkCmd.Flags().MarkHidden("mock")
+	_ = benchmarkCmd.Flags().MarkHidden("mock-fixture")
+	rootCmd.AddCommand(benchmarkCmd)
+}
+
+// benchmarkStats sums up one configuration's runs over the question set
+type benchmarkStats struct {
+	Questions int
+	Answered  int
+	Latency   time.Duration // Total over the answered questions
+	Requests  int           // Model requests over all questions, retries included
+
+	// Agreement is the share of reviewers that ranked the plurality winner
+	// first, summed over the Reviewed questions
+	Agreement float64
+	Reviewed  int
+
+	Correct int // Answers that contain their reference
+	Graded  int // Questions with a reference; unanswered ones count as wrong
+}
+
+func runBenchmark(cmd *cobra.Command, args []string) error {
+	questions, err := readListFile(benchQuestions, "questions")
+	if err != nil {
+		return err
+	}
+	if len(questions) == 0 {
+		return fmt.Errorf("questions file %s does not list any questions", benchQuestions)
+	}
+	var references []string
+	if benchReferences != "" {
+		if references, err = readListFile(benchReferences, "references"); err != nil {
+			return err
+		}
+		if len(references) != len(questions) {
+			return fmt.Errorf("references file %s has %d answers for %d questions", benchReferences, len(references), len(questions))
+		}
+	}
+
+	var names [2]string
+	var configs [2]council.Config
+	for i, value := range benchConfigs {
+		if names[i], configs[i], err = benchmarkConfig(value); err != nil {
+			return fmt.Errorf("--config-%c: %w", 'a'+i, err)
+		}
+	}
+
+	var stats [2]benchmarkStats
+	for i := range configs {
+		c, err := newCouncil(configs[i])
+		if err != nil {
+			return err
+		}
+		label := fmt.Sprintf("%c (%s)", 'A'+i, names[i])
+		stats[i] = runBenchmarkConfig(context.Background(), cmd.ErrOrStderr(), c, label, questions, references)
+		if err := c.Close(); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "⚠️  cleanup failed, sessions may have leaked: %v\n", err)
+		}
+	}
+
+	renderBenchmark(cmd.OutOrStdout(), names, stats, references != nil)
+	return nil
+}
+
+// benchmarkConfig reads a configuration given as a profile name or an
+// inline JSON object, and returns its name in the comparison table
+func benchmarkConfig(value string) (string, council.Config, error) {
+	var p config.Profile
+	var err error
+	name := strings.TrimSpace(value)
+	if strings.HasPrefix(name, "{") {
+		p, err = config.ParseProfileJSON(name)
+		name = "inline"
+	} else {
+		var profiles map[string]config.Profile
+		if profiles, err = config.LoadProfiles(resolveProfilesFile()); err == nil {
+			p, err = config.SelectProfile(profiles, name)
+		}
+	}
+	if err != nil {
+		return "", council.Config{}, err
+	}
+	if p.Description != "" && name == "inline" {
+		name = p.Description
+	}
+	cfg, err := profileCouncilConfig(p)
+	return name, cfg, err
+}
+
+// profileCouncilConfig turns a profile into a council configuration, with
+// the command line's defaults for the settings it leaves out
+func profileCouncilConfig(p config.Profile) (council.Config, error) {
+	cfg := council.Config{
+		Models:          p.Models,
+		Aggregator:      p.Aggregator,
+		Mode:            p.Mode,
+		Timeout:         time.Duration(p.Timeout) * time.Second,
+		PromptPrefix:    p.PromptPrefix,
+		PromptSuffix:    p.PromptSuffix,
+		MaxReviewPairs:  p.MaxReviewPairs,
+		ReviewQuorum:    p.ReviewQuorum,
+		ReviewerWeights: p.ReviewerWeights,
+	}
+	if len(cfg.Models) == 0 {
+		cfg.Models = council.DefaultModels()
+	}
+	if cfg.Aggregator == "" {
+		cfg.Aggregator = council.DefaultAggregator()
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = council.ModeCouncil
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 60 * time.Second
+	}
+	if cfg.Aggregator == council.NoAggregator {
+		if cfg.Mode != council.ModeCouncil && cfg.Mode != council.ModeGather {
+			return cfg, fmt.Errorf("aggregator %s conflicts with mode %s", council.NoAggregator, cfg.Mode)
+		}
+		cfg.Mode = council.ModeGather
+	}
+	if !council.IsMode(cfg.Mode) {
+		return cfg, fmt.Errorf("invalid mode %q: expected one of %s", cfg.Mode, strings.Join(council.Modes, ", "))
+	}
+	if cfg.Mode == council.ModeProposer && len(cfg.Models) < 2 {
+		return cfg, fmt.Errorf("proposer mode needs at least two models: a proposer and a critic")
+	}
+	cfg.Members = copilot.ModelMembers(cfg.Models)
+	return cfg, nil
+}
+
+// runBenchmarkConfig asks the council every question in turn, as
+// --multi-prompt does, and sums up how it did
+func runBenchmarkConfig(ctx context.Context, progress io.Writer, c *council.Council, label string, questions, references []string) benchmarkStats {
+	var mu sync.Mutex
+	starts := make([]time.Time, 0, len(questions))
+	requests := 0
+	handler := func(event council.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case event.Type == council.EventPhaseStart && event.Phase == council.PhaseQuestion:
+			starts = append(starts, event.Time)
+			fmt.Fprintf(progress, "%s: question %d/%d\n", label, event.Count, len(questions))
+		case event.Type == council.EventStarted:
+			requests++
+		}
+	}
+	result := c.ExecuteMulti(ctx, questions, false, handler)
+	end := time.Now()
+
+	latencies := make([]time.Duration, len(result.SubResults))
+	for i := range result.SubResults {
+		next := end
+		if i+1 < len(starts) {
+			next = starts[i+1]
+		}
+		latencies[i] = next.Sub(starts[i])
+	}
+	stats := summarizeBenchmark(result.SubResults, latencies, references)
+	stats.Questions = len(questions)
+	stats.Requests = requests
+	return stats
+}
+
+// summarizeBenchmark sums up the results of the questions and how long each
+// took; references, when given, hold each question's reference answer
+func summarizeBenchmark(results []council.Result, latencies []time.Duration, references []string) benchmarkStats {
+	stats := benchmarkStats{Questions: len(results)}
+	for i, result := range results {
+		answered := result.Error == nil && result.AggregatedResponse != ""
+		if i < len(references) {
+			stats.Graded++
+			if answered && matchesReference(result.AggregatedResponse, references[i]) {
+				stats.Correct++
+			}
+		}
+		if !answered {
+			continue
+		}
+		stats.Answered++
+		stats.Latency += latencies[i]
+		if reviews := council.Stats(result).Review.Succeeded; reviews > 0 && len(result.PluralityWinner) > 0 {
+			stats.Agreement += float64(result.PluralityVotes) / float64(reviews)
+			stats.Reviewed++
+		}
+	}
+	return stats
+}
+
+// matchesReference reports whether answer contains reference, ignoring case
+// and differences in whitespace
+func matchesReference(answer, reference string) bool {
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	}
+	return strings.Contains(normalize(answer), normalize(reference))
+}
+
+// renderBenchmark prints the comparison table of the two configurations
+func renderBenchmark(w io.Writer, names [2]string, stats [2]benchmarkStats, graded bool) {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	row := func(label string, value func(s benchmarkStats) string) {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", label, value(stats[0]), value(stats[1]))
+	}
+	fmt.Fprintf(tw, "\tA: %s\tB: %s\n", names[0], names[1])
+	row("Answered", func(s benchmarkStats) string {
+		return fmt.Sprintf("%d/%d", s.Answered, s.Questions)
+	})
+	row("Avg latency", func(s benchmarkStats) string {
+		if s.Answered == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.2fs", (s.Latency / time.Duration(s.Answered)).Seconds())
+	})
+	row("Avg requests", func(s benchmarkStats) string {
+		if s.Questions == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.1f", float64(s.Requests)/float64(s.Questions))
+	})
+	row("Agreement", func(s benchmarkStats) string {
+		if s.Reviewed == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.0f%%", 100*s.Agreement/float64(s.Reviewed))
+	})
+	if graded {
+		row("Accuracy", func(s benchmarkStats) string {
+			if s.Graded == 0 {
+				return "-"
+			}
+			return fmt.Sprintf("%d/%d (%.0f%%)", s.Correct, s.Graded, 100*float64(s.Correct)/float64(s.Graded))
+		})
+	}
+	tw.Flush()
+}
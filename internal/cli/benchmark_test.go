@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/config"
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestProfileCouncilConfig(t *testing.T) {
+	cfg, err := profileCouncilConfig(config.Profile{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Members) != len(council.DefaultModels()) || cfg.Aggregator != council.DefaultAggregator() || cfg.Mode != council.ModeCouncil || cfg.Timeout != time.Minute {
+		t.Errorf("an empty profile should get the defaults, got %+v", cfg)
+	}
+
+	cfg, err = profileCouncilConfig(config.Profile{Models: []string{"a", "b"}, Aggregator: council.NoAggregator, Timeout: 5})
+	if err != nil || cfg.Mode != council.ModeGather || cfg.Timeout != 5*time.Second {
+		t.Errorf("expected a gather council with a 5s timeout, got %+v (%v)", cfg, err)
+	}
+
+	for _, p := range []config.Profile{
+		{Mode: "debate"},
+		{Mode: council.ModeProposer, Models: []string{"a"}},
+		{Mode: council.ModeRoundtable, Aggregator: council.NoAggregator},
+	} {
+		if _, err := profileCouncilConfig(p); err == nil {
+			t.Errorf("expected an error for %+v", p)
+		}
+	}
+}
+
+func TestSummarizeBenchmark(t *testing.T) {
+	results := []council.Result{
+		{
+			AggregatedResponse: "Rayleigh scattering makes the sky blue.",
+			Reviews:            []council.Review{{ReviewerModel: "a"}, {ReviewerModel: "b"}, {ReviewerModel: "c"}, {ReviewerModel: "d", Skipped: true}},
+			PluralityWinner:    []string{"a"},
+			PluralityVotes:     2,
+		},
+		{AggregatedResponse: "Go was   designed at\nGOOGLE."},
+		{Error: errors.New("all models failed to respond")},
+	}
+	latencies := []time.Duration{2 * time.Second, 4 * time.Second, time.Second}
+
+	stats := summarizeBenchmark(results, latencies, []string{"rayleigh scattering", "designed at Google", "42"})
+	want := benchmarkStats{Questions: 3, Answered: 2, Latency: 6 * time.Second, Agreement: 2.0 / 3, Reviewed: 1, Correct: 2, Graded: 3}
+	if stats != want {
+		t.Errorf("stats = %+v\nwant    %+v", stats, want)
+	}
+
+	if stats := summarizeBenchmark(results, latencies, nil); stats.Graded != 0 || stats.Correct != 0 {
+		t.Errorf("nothing should be graded without references, got %+v", stats)
+	}
+}
+
+func TestRenderBenchmark(t *testing.T) {
+	var out bytes.Buffer
+	renderBenchmark(&out, [2]string{"fast", "thorough"}, [2]benchmarkStats{
+		{Questions: 2, Answered: 2, Latency: 3 * time.Second, Requests: 10, Agreement: 1.5, Reviewed: 2, Correct: 1, Graded: 2},
+		{Questions: 2},
+	}, true)
+
+	for _, want := range []string{"A: fast", "B: thorough", "2/2", "0/2", "1.50s", "5.0", "75%", "1/2 (50%)"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	renderBenchmark(&out, [2]string{"a", "b"}, [2]benchmarkStats{}, false)
+	if strings.Contains(out.String(), "Accuracy") {
+		t.Errorf("accuracy is only shown with references, got:\n%s", out.String())
+	}
+}
+
+func TestRunBenchmarkConfig(t *testing.T) {
+	cfg, err := profileCouncilConfig(config.Profile{Models: []string{"model-a", "model-b"}, Aggregator: "chairman"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := council.NewCouncilWithClient(cfg, copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a":  {{Content: "Blue light scatters more."}},
+			"model-b":  {{Content: "Rayleigh scattering."}},
+			"chairman": {{Content: "Rayleigh scattering of blue light."}},
+		},
+	}, false))
+
+	var progress bytes.Buffer
+	stats := runBenchmarkConfig(context.Background(), &progress, c, "A (test)", []string{"Why is the sky blue?", "Why is the sea blue?"}, []string{"rayleigh", "rayleigh"})
+
+	// Each question asks both members, has them review each other, and synthesizes
+	if stats.Questions != 2 || stats.Answered != 2 || stats.Requests != 10 || stats.Correct != 2 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	if !strings.Contains(progress.String(), "A (test): question 2/2") {
+		t.Errorf("expected progress per question, got %q", progress.String())
+	}
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// defaultCacheDir returns where cached entries of kind are kept, e.g.
+// $XDG_CACHE_HOME/copilot-council/aggregations (or the OS equivalent)
+func defaultCacheDir(kind string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the cache directory: %w", err)
+	}
+	return filepath.Join(dir, "copilot-council", kind), nil
+}
+
+// cacheEntry is the on-disk layout of one cached synthesis
+type cacheEntry struct {
+	Answer  string    `json:"answer"`
+	Created time.Time `json:"created"`
+}
+
+// fileCache stores one JSON file per synthesis. Entries older than ttl are
+// ignored; a ttl of 0 keeps them forever.
+type fileCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// Load implements council.AggregationCache and council.ResponseCache
+func (f *fileCache) Load(key string) (string, bool) {
+	data, err := os.ReadFile(filepath.Join(f.dir, key+".json"))
+	if err != nil {
+		return "", false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Answer == "" {
+		return "", false
+	}
+	if f.ttl > 0 && time.Since(entry.Created) > f.ttl {
+		return "", false
+	}
+	return entry.Answer, true
+}
+
+// Store implements council.AggregationCache and council.ResponseCache
+func (f *fileCache) Store(key, answer string) error {
+	data, err := json.Marshal(cacheEntry{Answer: answer, Created: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	return writeCacheFile(f.dir, key, data)
+}
+
+// writeCacheFile saves data as dir/key.json. It is written to a temporary
+// file first, so concurrent runs never read a partial entry.
+func writeCacheFile(dir, key string, data []byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, key+".json")); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "aggregations")
+	cache := &fileCache{dir: dir, ttl: time.Hour}
+
+	if _, ok := cache.Load("abc"); ok {
+		t.Fatal("expected a miss on an empty cache")
+	}
+	if err := cache.Store("abc", "The answer"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, ok := cache.Load("abc"); !ok || got != "The answer" {
+		t.Errorf("Load = %q, %v", got, ok)
+	}
+
+	// Only the entry itself is left behind
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != "abc.json" {
+		t.Errorf("unexpected cache files: %v", entries)
+	}
+}
+
+func TestFileCacheExpires(t *testing.T) {
+	dir := t.TempDir()
+	stale := `{"answer": "Old answer", "created": "2020-01-01T00:00:00Z"}`
+	if err := os.WriteFile(filepath.Join(dir, "abc.json"), []byte(stale), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := (&fileCache{dir: dir, ttl: time.Hour}).Load("abc"); ok {
+		t.Error("expected an expired entry to miss")
+	}
+	if got, ok := (&fileCache{dir: dir}).Load("abc"); !ok || got != "Old answer" {
+		t.Errorf("expected a zero TTL to keep entries forever, got %q, %v", got, ok)
+	}
+}
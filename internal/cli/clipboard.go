@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// errNoClipboard reports that this session has no clipboard to read, as on
+// a headless server or in CI
+var errNoClipboard = errors.New("no clipboard available")
+
+// clipboardReader reads the text on the clipboard
+type clipboardReader interface {
+	ReadClipboard() (string, error)
+}
+
+// clipboard is where --from-clipboard reads the question
+var clipboard clipboardReader = systemClipboard{}
+
+// systemClipboard reads the system clipboard with the platform's own tool.
+// Clipboard libraries such as atotto/clipboard run these same tools outside
+// Windows, so one would add a dependency without dropping the tools.
+type systemClipboard struct{}
+
+// clipboardCommands lists the commands that print the clipboard on goos, in
+// order of preference. On Linux and the BSDs they depend on the display
+// server; without one there is no clipboard and the list is empty.
+func clipboardCommands(goos string, getenv func(string) string) [][]string {
+	switch goos {
+	case "darwin":
+		return [][]string{{"pbpaste"}}
+	case "windows":
+		return [][]string{{"powershell", "-NoProfile", "-Command", "Get-Clipboard"}}
+	}
+	var commands [][]string
+	if getenv("WAYLAND_DISPLAY") != "" {
+		commands = append(commands, []string{"wl-paste", "--no-newline"})
+	}
+	if getenv("DISPLAY") != "" {
+		commands = append(commands, []string{"xclip", "-selection", "clipboard", "-o"}, []string{"xsel", "--clipboard", "--output"})
+	}
+	return commands
+}
+
+// ReadClipboard runs the first clipboard tool that is installed
+func (systemClipboard) ReadClipboard() (string, error) {
+	commands := clipboardCommands(runtime.GOOS, os.Getenv)
+	if len(commands) == 0 {
+		return "", fmt.Errorf("%w: no display server (WAYLAND_DISPLAY and DISPLAY are unset)", errNoClipboard)
+	}
+	var tools []string
+	for _, argv := range commands {
+		if _, err := exec.LookPath(argv[0]); err != nil {
+			tools = append(tools, argv[0])
+			continue
+		}
+		out, err := exec.Command(argv[0], argv[1:]...).Output()
+		if err != nil {
+			return "", fmt.Errorf("failed to read the clipboard with %s: %w", argv[0], err)
+		}
+		return string(out), nil
+	}
+	return "", fmt.Errorf("%w: install %s", errNoClipboard, strings.Join(tools, " or "))
+}
+
+// readClipboardQuestion reads the question from the clipboard
+func readClipboardQuestion(reader clipboardReader) (string, error) {
+	text, err := reader.ReadClipboard()
+	if err != nil {
+		return "", err
+	}
+	question := strings.TrimSpace(text)
+	if question == "" {
+		return "", fmt.Errorf("the clipboard is empty")
+	}
+	return question, nil
+}
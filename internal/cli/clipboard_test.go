@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// fakeClipboard holds fixed clipboard contents
+type fakeClipboard struct {
+	text string
+	err  error
+}
+
+func (f fakeClipboard) ReadClipboard() (string, error) { return f.text, f.err }
+
+func TestReadClipboardQuestion(t *testing.T) {
+	question, err := readClipboardQuestion(fakeClipboard{text: "  What does this code do?\n\nfunc main() {}\n"})
+	if err != nil || question != "What does this code do?\n\nfunc main() {}" {
+		t.Errorf("got %q, %v", question, err)
+	}
+
+	if _, err := readClipboardQuestion(fakeClipboard{text: " \n"}); err == nil || !strings.Contains(err.Error(), "empty") {
+		t.Errorf("expected an empty clipboard error, got %v", err)
+	}
+
+	headless := fakeClipboard{err: errNoClipboard}
+	if _, err := readClipboardQuestion(headless); !errors.Is(err, errNoClipboard) {
+		t.Errorf("expected errNoClipboard, got %v", err)
+	}
+}
+
+func TestClipboardCommands(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(name string) string { return vars[name] }
+	}
+	names := func(commands [][]string) string {
+		var names []string
+		for _, argv := range commands {
+			names = append(names, argv[0])
+		}
+		return strings.Join(names, ",")
+	}
+
+	tests := []struct {
+		goos string
+		env  map[string]string
+		want string
+	}{
+		{"darwin", nil, "pbpaste"},
+		{"windows", nil, "powershell"},
+		{"linux", nil, ""}, // Headless: no clipboard
+		{"linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, "wl-paste"},
+		{"linux", map[string]string{"DISPLAY": ":0"}, "xclip,xsel"},
+		{"freebsd", map[string]string{"WAYLAND_DISPLAY": "wayland-0", "DISPLAY": ":0"}, "wl-paste,xclip,xsel"},
+	}
+	for _, tt := range tests {
+		if got := names(clipboardCommands(tt.goos, env(tt.env))); got != tt.want {
+			t.Errorf("%s %v: got %q, want %q", tt.goos, tt.env, got, tt.want)
+		}
+	}
+}
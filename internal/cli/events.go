@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+)
+
+// eventLog receives every event of the run as NDJSON, with --events-file
+var eventLog *output.EventWriter
+
+// handleEvents returns the handler for a run's events: the printer, and
+// the events file when one is open
+func handleEvents(printer *output.Printer) council.EventHandler {
+	if eventLog == nil {
+		return printer.HandleEvent
+	}
+	return council.FanOut(printer.HandleEvent, eventLog.Handle)
+}
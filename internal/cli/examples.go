@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// readExamplesFile reads prior conversation turns from a JSON array of
+// {"role": "user"|"assistant", "content": "..."} objects
+func readExamplesFile(path string) ([]council.Message, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read examples file: %w", err)
+	}
+
+	var messages []council.Message
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("failed to parse examples file %s: %w", path, err)
+	}
+
+	for i, msg := range messages {
+		role := strings.ToLower(strings.TrimSpace(msg.Role))
+		if role != council.RoleUser && role != council.RoleAssistant {
+			return nil, fmt.Errorf("examples file %s: message %d has role %q, expected user or assistant", path, i+1, msg.Role)
+		}
+		if strings.TrimSpace(msg.Content) == "" {
+			return nil, fmt.Errorf("examples file %s: message %d is empty", path, i+1)
+		}
+		messages[i].Role = role
+	}
+	return messages, nil
+}
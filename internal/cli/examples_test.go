@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/openjny/council/internal/council"
+)
+
+func TestReadExamplesFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	path := write("examples.json", `[
+		{"role": "user", "content": "Name a prime."},
+		{"role": "Assistant", "content": "7"}
+	]`)
+	got, err := readExamplesFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []council.Message{
+		{Role: council.RoleUser, Content: "Name a prime."},
+		{Role: council.RoleAssistant, Content: "7"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readExamplesFile() = %+v, want %+v", got, want)
+	}
+
+	for name, content := range map[string]string{
+		"role.json":    `[{"role": "system", "content": "x"}]`,
+		"empty.json":   `[{"role": "user", "content": "  "}]`,
+		"invalid.json": `{"role": "user"}`,
+	} {
+		if _, err := readExamplesFile(write(name, content)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+	"golang.org/x/term"
+)
+
+// keyPollInterval is how often the key watcher checks whether to stop
+const keyPollInterval = 100 * time.Millisecond
+
+// watchSkipKey calls skip whenever 's' is pressed, until the returned stop
+// function is called. It only watches an interactive terminal: with stdin
+// piped or in CI it does nothing and reports false.
+func watchSkipKey(skip func()) (stop func(), ok bool) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) || !term.IsTerminal(int(os.Stdout.Fd())) || os.Getenv("CI") == "true" {
+		return func() {}, false
+	}
+	restore, err := cbreak(fd, keyPollInterval)
+	if err != nil {
+		return func() {}, false
+	}
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		buf := make([]byte, 1)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			// Reads give up after keyPollInterval without a key
+			if n, _ := os.Stdin.Read(buf); n == 1 && (buf[0] == 's' || buf[0] == 'S') {
+				skip()
+			}
+		}
+	}()
+
+	var once sync.Once
+	stopWatching := func() {
+		once.Do(func() {
+			close(done)
+			<-finished
+			restore()
+		})
+	}
+
+	// Ctrl-C would otherwise end the process with echo still off
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, interruptSignals...)
+	go restoreOnSignal(sigs, done, stopWatching, func(sig os.Signal) {
+		signal.Stop(sigs)
+		if p, err := os.FindProcess(os.Getpid()); err == nil {
+			_ = p.Signal(sig)
+		}
+	})
+
+	return func() {
+		signal.Stop(sigs)
+		stopWatching()
+	}, true
+}
+
+// interruptSignals end a run; the terminal is restored before they take effect
+var interruptSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// restoreOnSignal waits for a signal on sigs, restores the terminal and
+// raises the signal again so that it has its usual effect. It returns without
+// doing anything once done is closed.
+func restoreOnSignal(sigs <-chan os.Signal, done <-chan struct{}, restore func(), raise func(os.Signal)) {
+	select {
+	case sig := <-sigs:
+		restore()
+		raise(sig)
+	case <-done:
+	}
+}
+
+// withSkipKey runs fn, letting the user press 's' to stop waiting for the
+// members still answering c's current query phase
+func withSkipKey(c *council.Council, printer *output.Printer, fn func()) {
+	stop, ok := watchSkipKey(func() { c.SkipPending() })
+	defer stop()
+	printer.SetSkipHint(ok)
+	fn()
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !darwin
+
+package cli
+
+import (
+	"errors"
+	"time"
+)
+
+// cbreak is only implemented for Linux and macOS terminals
+func cbreak(fd int, timeout time.Duration) (restore func(), err error) {
+	return nil, errors.New("key watching is not supported on this platform")
+}
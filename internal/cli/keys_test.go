@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"os"
+	"testing"
+)
+
+func TestRestoreOnSignal(t *testing.T) {
+	sigs := make(chan os.Signal, 1)
+	sigs <- os.Interrupt
+	restored := 0
+	var raised os.Signal
+	restoreOnSignal(sigs, make(chan struct{}), func() { restored++ }, func(sig os.Signal) {
+		if restored != 1 {
+			t.Error("the terminal should be restored before the signal is raised again")
+		}
+		raised = sig
+	})
+	if restored != 1 || raised != os.Interrupt {
+		t.Errorf("restored %d times and raised %v, want once and interrupt", restored, raised)
+	}
+
+	done := make(chan struct{})
+	close(done)
+	restoreOnSignal(make(chan os.Signal), done, func() { t.Error("restored without a signal") }, func(os.Signal) {
+		t.Error("raised without a signal")
+	})
+}
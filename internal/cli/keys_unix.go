@@ -0,0 +1,29 @@
+//go:build linux || darwin
+
+package cli
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// cbreak makes the terminal deliver keys as they are pressed, without echo,
+// and makes reads return after timeout without a key. Unlike raw mode it
+// leaves output processing alone, so printing goes on as usual. The returned
+// function restores the previous settings.
+func cbreak(fd int, timeout time.Duration) (restore func(), err error) {
+	old, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := *old
+	settings.Lflag &^= unix.ICANON | unix.ECHO
+	settings.Cc[unix.VMIN] = 0
+	settings.Cc[unix.VTIME] = uint8(timeout / (100 * time.Millisecond)) // In tenths of a second
+	if err := unix.IoctlSetTermios(fd, ioctlWriteTermios, &settings); err != nil {
+		return nil, err
+	}
+	return func() { _ = unix.IoctlSetTermios(fd, ioctlWriteTermios, old) }, nil
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/output"
+)
+
+// defaultLatencyFile returns where observed model latencies are kept:
+// $XDG_CACHE_HOME/copilot-council/latency.json (or the OS equivalent)
+func defaultLatencyFile() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the cache directory: %w", err)
+	}
+	return filepath.Join(dir, "copilot-council", "latency.json"), nil
+}
+
+// loadLatencies reads the typical response time of each model. A missing
+// file means no history yet and yields no hints.
+func loadLatencies(path string) (map[string]time.Duration, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read latency history: %w", err)
+	}
+
+	var seconds map[string]float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return nil, fmt.Errorf("failed to parse latency history %s: %w", path, err)
+	}
+	hints := make(map[string]time.Duration, len(seconds))
+	for model, s := range seconds {
+		hints[model] = time.Duration(s * float64(time.Second))
+	}
+	return hints, nil
+}
+
+// updateLatencies blends the durations of this run's successful answers into
+// hints, weighting the history and the new sample equally so that a model
+// that slowed down moves back within a few runs
+func updateLatencies(hints map[string]time.Duration, responses []copilot.Response) map[string]time.Duration {
+	updated := make(map[string]time.Duration, len(hints)+len(responses))
+	for model, d := range hints {
+		updated[model] = d
+	}
+	for _, resp := range responses {
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		if old, ok := updated[resp.Model]; ok {
+			updated[resp.Model] = (old + resp.Duration) / 2
+		} else {
+			updated[resp.Model] = resp.Duration
+		}
+	}
+	return updated
+}
+
+// saveLatencies writes hints to path through a temporary file, so concurrent
+// runs never read a partial history
+func saveLatencies(path string, hints map[string]time.Duration) error {
+	seconds := make(map[string]float64, len(hints))
+	for model, d := range hints {
+		seconds[model] = d.Seconds()
+	}
+	data, err := json.MarshalIndent(seconds, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode latency history: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, "latency.*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write latency history: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write latency history: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write latency history: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write latency history: %w", err)
+	}
+	return nil
+}
+
+// recordLatencies folds this run's response times into the latency history
+// used by --models-weight-by-latency and --auto-order. Failures only cost the hint, so they
+// are reported as warnings.
+func recordLatencies(printer *output.Printer, responses []copilot.Response) {
+	path, err := defaultLatencyFile()
+	if err != nil {
+		printer.PrintWarning("%v", err)
+		return
+	}
+	hints, err := loadLatencies(path)
+	if err != nil {
+		printer.PrintWarning("%v", err)
+	}
+	if err := saveLatencies(path, updateLatencies(hints, responses)); err != nil {
+		printer.PrintWarning("%v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestLatencyHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "copilot-council", "latency.json")
+
+	hints, err := loadLatencies(path)
+	if err != nil || hints != nil {
+		t.Fatalf("expected no hints without history, got %v, %v", hints, err)
+	}
+
+	hints = updateLatencies(hints, []copilot.Response{
+		{Model: "model-a", Content: "a", Duration: 4 * time.Second},
+		{Model: "model-b", Error: errors.New("boom"), Duration: time.Second},
+	})
+	if err := saveLatencies(path, hints); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hints, err = loadLatencies(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := hints["model-b"]; ok {
+		t.Error("failed responses should not be recorded")
+	}
+
+	hints = updateLatencies(hints, []copilot.Response{{Model: "model-a", Content: "a", Duration: 2 * time.Second}})
+	if got := hints["model-a"]; got != 3*time.Second {
+		t.Errorf("expected the new sample blended in, got %v", got)
+	}
+}
+
+func TestOrderByLatency(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "latency.json")
+	if err := os.WriteFile(path, []byte(`{"model-b": 2.5, "model-c": 0.8, "other": 0.1}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	hints, err := loadLatencies(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	models := []string{"model-a", "model-b", "model-c", "model-d"}
+	got := council.OrderByLatency(models, hints)
+	want := []string{"model-c", "model-b", "model-a", "model-d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderByLatency = %v, want %v", got, want)
+	}
+	if models[0] != "model-a" {
+		t.Error("OrderByLatency must not reorder its input")
+	}
+
+	if got := council.OrderByLatency(models, nil); !reflect.DeepEqual(got, models) {
+		t.Errorf("expected the configured order without history, got %v", got)
+	}
+}
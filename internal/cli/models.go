@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+// readModelsFile reads a model list with one model name per line.
+// Blank lines and lines starting with '#' are ignored.
+func readModelsFile(path string) ([]string, error) {
+	list, err := readListFile(path, "models")
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("models file %s does not list any models", path)
+	}
+	return list, nil
+}
+
+// mergeModelsFile adds the models listed in path to models typed with
+// --models, or replaces models that only come from the defaults or a profile
+func mergeModelsFile(models []string, typed bool, path string) ([]string, error) {
+	fileModels, err := readModelsFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if typed {
+		return append(models, fileModels...), nil
+	}
+	return fileModels, nil
+}
+
+// readListFile reads a what file with one entry per line. Blank lines and
+// lines starting with '#' are ignored.
+func readListFile(path, what string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s file: %w", what, err)
+	}
+	defer f.Close()
+
+	var list []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list = append(list, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s file: %w", what, err)
+	}
+	return list, nil
+}
+
+// filterModels narrows the configured models to only (when given) and drops
+// exclude, keeping the configured order. Naming a model that is not
+// configured is an error, so typos do not silently change the council.
+func filterModels(models, only, exclude []string) ([]string, error) {
+	configured := make(map[string]bool, len(models))
+	for _, model := range models {
+		configured[model] = true
+	}
+	for _, check := range []struct {
+		flag  string
+		names []string
+	}{{"--only-models", only}, {"--exclude-models", exclude}} {
+		for _, name := range check.names {
+			if !configured[name] {
+				return nil, fmt.Errorf("%s: %q is not one of the configured models (%s)", check.flag, name, strings.Join(models, ", "))
+			}
+		}
+	}
+
+	keep := make(map[string]bool, len(models))
+	for _, model := range models {
+		keep[model] = len(only) == 0
+	}
+	for _, name := range only {
+		keep[name] = true
+	}
+	for _, name := range exclude {
+		keep[name] = false
+	}
+
+	filtered := make([]string, 0, len(models))
+	for _, model := range models {
+		if keep[model] {
+			filtered = append(filtered, model)
+		}
+	}
+	return filtered, nil
+}
+
+// parseReviewerWeights parses repeated "model=weight" values into a map
+func parseReviewerWeights(values []string) (map[string]float64, error) {
+	weights := make(map[string]float64, len(values))
+	for _, value := range values {
+		model, raw, ok := strings.Cut(value, "=")
+		model = strings.TrimSpace(model)
+		if !ok || model == "" {
+			return nil, fmt.Errorf("invalid reviewer weight %q: expected model=weight", value)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid reviewer weight %q: weight must be a non-negative number", value)
+		}
+		weights[model] = weight
+	}
+	return weights, nil
+}
+
+// parseContextWindows parses repeated "model=tokens" values into context
+// window sizes by model
+func parseContextWindows(values []string) (map[string]int, error) {
+	windows := make(map[string]int, len(values))
+	for _, value := range values {
+		model, raw, ok := strings.Cut(value, "=")
+		model = strings.TrimSpace(model)
+		if !ok || model == "" {
+			return nil, fmt.Errorf("invalid context window %q: expected model=tokens", value)
+		}
+		tokens, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || tokens <= 0 {
+			return nil, fmt.Errorf("invalid context window %q: tokens must be a positive integer", value)
+		}
+		windows[model] = tokens
+	}
+	return windows, nil
+}
+
+// parseMinLength parses the minimum answer length given to flag: a number
+// of characters, or of words when followed by "w" or "words" (e.g. "40w")
+func parseMinLength(flag, value string) (length int, words bool, err error) {
+	number := strings.TrimSpace(value)
+	for _, suffix := range []string{"words", "w"} {
+		if trimmed, ok := strings.CutSuffix(number, suffix); ok {
+			number, words = strings.TrimSpace(trimmed), true
+			break
+		}
+	}
+	length, err = strconv.Atoi(number)
+	if err != nil || length < 0 {
+		return 0, false, fmt.Errorf("invalid %s %q: expected a number of characters, or of words as Nw", flag, value)
+	}
+	return length, words, nil
+}
+
+// parsePersonas parses repeated "name=system-prompt" values into council members.
+// Every persona runs on model unless it names its own as "name@model=prompt".
+func parsePersonas(values []string, model string) ([]copilot.Member, error) {
+	members := make([]copilot.Member, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		name, prompt, ok := strings.Cut(value, "=")
+		name, prompt = strings.TrimSpace(name), strings.TrimSpace(prompt)
+		if !ok || name == "" || prompt == "" {
+			return nil, fmt.Errorf("invalid persona %q: expected name=system-prompt", value)
+		}
+
+		member := copilot.Member{Name: name, Model: model, SystemPrompt: prompt}
+		if label, override, ok := strings.Cut(name, "@"); ok {
+			member.Name, member.Model = strings.TrimSpace(label), strings.TrimSpace(override)
+			if member.Name == "" || member.Model == "" {
+				return nil, fmt.Errorf("invalid persona %q: expected name@model=system-prompt", value)
+			}
+		}
+
+		if seen[member.Name] {
+			return nil, fmt.Errorf("duplicate persona %q", member.Name)
+		}
+		seen[member.Name] = true
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// parseModelOptions parses repeated "model:key=value" values into per-model
+// message options. The model is everything before the last ':' of the key part,
+// so provider-qualified model names keep working.
+func parseModelOptions(values []string) (map[string]copilot.SendOptions, error) {
+	options := make(map[string]copilot.SendOptions)
+	for _, value := range values {
+		target, optValue, ok := strings.Cut(value, "=")
+		sep := strings.LastIndex(target, ":")
+		if !ok || sep <= 0 || sep == len(target)-1 {
+			return nil, fmt.Errorf("invalid model option %q: expected model:key=value", value)
+		}
+		model := strings.TrimSpace(target[:sep])
+
+		opts := options[model]
+		if err := opts.Set(target[sep+1:], optValue); err != nil {
+			return nil, fmt.Errorf("invalid model option %q: %w", value, err)
+		}
+		options[model] = opts
+	}
+	return options, nil
+}
+
+// parseExecModel parses a --exec-model value of the form name:command. The
+// command is split like --pipe-to, without a shell.
+func parseExecModel(value string) (council.ExecModel, error) {
+	name, command, ok := strings.Cut(value, ":")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" {
+		return council.ExecModel{}, fmt.Errorf("invalid --exec-model %q: expected name:command", value)
+	}
+	argv, err := splitCommand(command)
+	if err != nil {
+		return council.ExecModel{}, fmt.Errorf("invalid --exec-model %q: %w", value, err)
+	}
+	return council.ExecModel{Name: name, Command: argv}, nil
+}
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openjny/council/internal/config"
+	"github.com/openjny/council/internal/copilot"
+	"github.com/spf13/cobra"
+)
+
+func TestReadModelsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "models.txt")
+	content := "# coding council\n  claude-sonnet-4.5  \n\ngpt-5.2\n# gemini-3-pro-preview\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readModelsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"claude-sonnet-4.5", "gpt-5.2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readModelsFile() = %v, want %v", got, want)
+	}
+
+	empty := filepath.Join(dir, "empty.txt")
+	if err := os.WriteFile(empty, []byte("# nothing here\n\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readModelsFile(empty); err == nil {
+		t.Error("expected error for a file without models")
+	}
+
+	if _, err := readModelsFile(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestMergeModelsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "models.txt")
+	if err := os.WriteFile(path, []byte("gpt-5.2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		args []string
+		want []string
+	}{
+		{nil, []string{"gpt-5.2"}},
+		{[]string{"--models", "typed-model"}, []string{"typed-model", "gpt-5.2"}},
+	} {
+		var models []string
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().StringSliceVar(&models, "models", []string{"default-model"}, "")
+		if err := cmd.Flags().Parse(tc.args); err != nil {
+			t.Fatal(err)
+		}
+
+		// The profile's models are replaced like defaults, not kept like typed ones
+		typed := cmd.Flags().Changed("models")
+		if err := applyProfile(cmd, config.Profile{Models: []string{"profile-model"}}); err != nil {
+			t.Fatal(err)
+		}
+		got, err := mergeModelsFile(models, typed, path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("args %v: mergeModelsFile() = %v, want %v", tc.args, got, tc.want)
+		}
+	}
+
+	if _, err := mergeModelsFile(nil, false, filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestParseReviewerWeights(t *testing.T) {
+	got, err := parseReviewerWeights([]string{"gpt-5.2=1.5", " gemini-3-pro-preview = 0 "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]float64{"gpt-5.2": 1.5, "gemini-3-pro-preview": 0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseReviewerWeights() = %v, want %v", got, want)
+	}
+
+	for _, bad := range []string{"gpt-5.2", "=1", "gpt-5.2=heavy", "gpt-5.2=-1"} {
+		if _, err := parseReviewerWeights([]string{bad}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseContextWindows(t *testing.T) {
+	got, err := parseContextWindows([]string{"gpt-5.2=128000", " claude-sonnet-4.5 = 200000 "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]int{"gpt-5.2": 128000, "claude-sonnet-4.5": 200000}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseContextWindows() = %v, want %v", got, want)
+	}
+
+	for _, bad := range []string{"gpt-5.2", "=1000", "gpt-5.2=large", "gpt-5.2=0", "gpt-5.2=1.5"} {
+		if _, err := parseContextWindows([]string{bad}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseMinLength(t *testing.T) {
+	tests := []struct {
+		value  string
+		length int
+		words  bool
+	}{
+		{"0", 0, false},
+		{"200", 200, false},
+		{"40w", 40, true},
+		{" 40 words ", 40, true},
+	}
+	for _, tt := range tests {
+		length, words, err := parseMinLength("--min-response-length", tt.value)
+		if err != nil || length != tt.length || words != tt.words {
+			t.Errorf("parseMinLength(%q) = %d, %v, %v; want %d, %v", tt.value, length, words, err, tt.length, tt.words)
+		}
+	}
+
+	for _, bad := range []string{"", "w", "-1", "many", "40 chars"} {
+		if _, _, err := parseMinLength("--min-response-length", bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+	if _, _, err := parseMinLength("--min-review-length", "short"); err == nil || !strings.Contains(err.Error(), "--min-review-length") {
+		t.Errorf("expected the error to name the flag, got %v", err)
+	}
+}
+
+func TestFilterModels(t *testing.T) {
+	models := []string{"claude-sonnet-4.5", "gpt-5.2", "gemini-3-pro-preview"}
+
+	tests := []struct {
+		only, exclude, want []string
+	}{
+		{[]string{"gemini-3-pro-preview", "gpt-5.2"}, nil, []string{"gpt-5.2", "gemini-3-pro-preview"}},
+		{nil, []string{"gpt-5.2"}, []string{"claude-sonnet-4.5", "gemini-3-pro-preview"}},
+		{[]string{"gpt-5.2", "claude-sonnet-4.5"}, []string{"gpt-5.2"}, []string{"claude-sonnet-4.5"}},
+		{nil, nil, models},
+	}
+	for _, tt := range tests {
+		got, err := filterModels(models, tt.only, tt.exclude)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("filterModels(only=%v, exclude=%v) = %v, want %v", tt.only, tt.exclude, got, tt.want)
+		}
+	}
+
+	if _, err := filterModels(models, []string{"gpt-4o"}, nil); err == nil {
+		t.Error("expected an error for an --only-models name that is not configured")
+	}
+	if _, err := filterModels(models, nil, []string{"gpt-4o"}); err == nil {
+		t.Error("expected an error for an --exclude-models name that is not configured")
+	}
+}
+
+func TestParseExecModel(t *testing.T) {
+	got, err := parseExecModel("local:ollama run 'llama 3'")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "local" || !reflect.DeepEqual(got.Command, []string{"ollama", "run", "llama 3"}) {
+		t.Errorf("unexpected exec model: %+v", got)
+	}
+
+	for _, bad := range []string{"local", ":cat", "local:", "local:'cat"} {
+		if _, err := parseExecModel(bad); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+}
+
+func TestParsePersonas(t *testing.T) {
+	got, err := parsePersonas([]string{
+		"skeptic=Question every assumption.",
+		"optimist@gpt-5.2 = Focus on what could go right.",
+	}, "claude-sonnet-4.5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []copilot.Member{
+		{Name: "skeptic", Model: "claude-sonnet-4.5", SystemPrompt: "Question every assumption."},
+		{Name: "optimist", Model: "gpt-5.2", SystemPrompt: "Focus on what could go right."},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parsePersonas() = %+v, want %+v", got, want)
+	}
+
+	for _, bad := range [][]string{{"skeptic"}, {"=prompt"}, {"skeptic="}, {"@gpt-5.2=prompt"}, {"a=x", "a=y"}} {
+		if _, err := parsePersonas(bad, "gpt-5.2"); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseModelOptions(t *testing.T) {
+	got, err := parseModelOptions([]string{"gpt-5.2:mode=immediate", "openai:gpt-5:mode=enqueue"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["gpt-5.2"].Mode != "immediate" || got["openai:gpt-5"].Mode != "enqueue" || len(got) != 2 {
+		t.Errorf("parseModelOptions() = %+v", got)
+	}
+
+	for _, bad := range []string{"gpt-5.2", "gpt-5.2=1", ":mode=x", "gpt-5.2:=x", "gpt-5.2:max_tokens=2000", "gpt-5.2:colour=red"} {
+		if _, err := parseModelOptions([]string{bad}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+)
+
+// readMultiPromptFile reads the questions of a multi-prompt run from a JSON array of strings
+func readMultiPromptFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read multi-prompt file: %w", err)
+	}
+
+	var questions []string
+	if err := json.Unmarshal(data, &questions); err != nil {
+		return nil, fmt.Errorf("failed to parse multi-prompt file %s: %w", path, err)
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("multi-prompt file %s has no questions", path)
+	}
+	for i, question := range questions {
+		questions[i] = strings.TrimSpace(question)
+		if questions[i] == "" {
+			return nil, fmt.Errorf("multi-prompt file %s: question %d is empty", path, i+1)
+		}
+	}
+	return questions, nil
+}
+
+// executeMulti runs the council on each question, then prints the answers,
+// the merged answer with --meta-aggregate, and the summary
+func executeMulti(ctx context.Context, c *council.Council, printer *output.Printer, questions []string) error {
+	startTime := time.Now()
+
+	var result council.Result
+	withSkipKey(c, printer, func() {
+		result = c.ExecuteMulti(ctx, questions, metaAggregate, handleEvents(printer))
+	})
+	printer.EndCIGroup()
+	printer.StopHeartbeat()
+
+	printer.PrintBlankLine() // Space after spinners
+	for _, sub := range result.SubResults {
+		printPanics(printer, sub)
+	}
+	if csvAppend != "" {
+		appendTimings(printer, questions, result.SubResults, 0)
+	}
+	printer.PrintSubAnswers(questions, result.SubResults)
+	if result.Error != nil {
+		printer.PrintError(result.Error)
+		return result.Error
+	}
+
+	// Without a meta answer the combined answer is the list just printed
+	if metaAggregate || quiet {
+		printer.PrintFinalResult(result.AggregatedResponse)
+	}
+	printer.PrintMultiSummary(result, time.Since(startTime))
+	return nil
+}
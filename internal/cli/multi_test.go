@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadMultiPromptFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	got, err := readMultiPromptFile(write("questions.json", `["  What is Go? ", "Why use it?"]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"What is Go?", "Why use it?"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("questions = %q, want %q", got, want)
+	}
+
+	for name, content := range map[string]string{
+		"empty.json":   `[]`,
+		"blank.json":   `["What is Go?", " "]`,
+		"invalid.json": `{"questions": []}`,
+	} {
+		if _, err := readMultiPromptFile(write(name, content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
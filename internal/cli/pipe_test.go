@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    []string
+		wantErr bool
+	}{
+		{command: "pbcopy", want: []string{"pbcopy"}},
+		{command: "tee  -a answer.txt", want: []string{"tee", "-a", "answer.txt"}},
+		{command: `notify-send "Council done" 'it''s ready'`, want: []string{"notify-send", "Council done", "its ready"}},
+		{command: `tee my\ file.txt`, want: []string{"tee", "my file.txt"}},
+		{command: `echo "a \"quoted\" word"`, want: []string{"echo", `a "quoted" word`}},
+		{command: `echo ''`, want: []string{"echo", ""}},
+		{command: "   ", wantErr: true},
+		{command: `echo "unterminated`, wantErr: true},
+		{command: `echo trailing\`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := splitCommand(tt.command)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("splitCommand(%q): expected error, got %q", tt.command, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("splitCommand(%q): unexpected error: %v", tt.command, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitCommand(%q) = %q, want %q", tt.command, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openjny/council/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var profilesCmd = &cobra.Command{
+	Use:   "profiles",
+	Short: "List the council profiles defined in the profiles file",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profiles, err := config.LoadProfiles(resolveProfilesFile())
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		for _, name := range config.ProfileNames(profiles) {
+			p := profiles[name]
+			if p.Description != "" {
+				fmt.Fprintf(out, "%-16s %s\n", name, p.Description)
+			} else {
+				fmt.Fprintln(out, name)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(profilesCmd)
+}
+
+// resolveProfilesFile returns --profiles-file, or the default location
+func resolveProfilesFile() string {
+	if profilesFile != "" {
+		return profilesFile
+	}
+	return config.DefaultProfilesPath()
+}
+
+// useProfile loads the named profile and applies it to cmd's flags
+func useProfile(cmd *cobra.Command, name string) error {
+	profiles, err := config.LoadProfiles(resolveProfilesFile())
+	if err != nil {
+		return err
+	}
+	p, err := config.SelectProfile(profiles, name)
+	if err != nil {
+		return err
+	}
+	return applyProfile(cmd, p)
+}
+
+// applyProfile copies the profile's settings onto flags the user did not set explicitly
+func applyProfile(cmd *cobra.Command, p config.Profile) error {
+	flags := cmd.Flags()
+	values := map[string][]string{}
+
+	if len(p.Models) > 0 {
+		values["models"] = []string{strings.Join(p.Models, ",")}
+	}
+	if p.Aggregator != "" {
+		values["aggregator"] = []string{p.Aggregator}
+	}
+	if p.Mode != "" {
+		values["mode"] = []string{p.Mode}
+	}
+	if p.Timeout > 0 {
+		values["timeout"] = []string{strconv.Itoa(p.Timeout)}
+	}
+	if p.PromptPrefix != "" {
+		values["prompt-prefix"] = []string{p.PromptPrefix}
+	}
+	if p.PromptSuffix != "" {
+		values["prompt-suffix"] = []string{p.PromptSuffix}
+	}
+	if p.MaxReviewPairs > 0 {
+		values["max-review-pairs"] = []string{strconv.Itoa(p.MaxReviewPairs)}
+	}
+	if p.ReviewQuorum > 0 {
+		values["review-quorum"] = []string{strconv.Itoa(p.ReviewQuorum)}
+	}
+	for model, weight := range p.ReviewerWeights {
+		values["reviewer-weight"] = append(values["reviewer-weight"], fmt.Sprintf("%s=%g", model, weight))
+	}
+
+	for name, vals := range values {
+		if flags.Changed(name) {
+			continue
+		}
+		for _, v := range vals {
+			if err := flags.Set(name, v); err != nil {
+				return fmt.Errorf("invalid %s in profile: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
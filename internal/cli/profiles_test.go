@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openjny/council/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func TestApplyProfileRespectsExplicitFlags(t *testing.T) {
+	var (
+		gotModels     []string
+		gotAggregator string
+		gotTimeout    int
+		gotWeights    []string
+	)
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringSliceVar(&gotModels, "models", []string{"default-model"}, "")
+	cmd.Flags().StringVar(&gotAggregator, "aggregator", "default-aggregator", "")
+	cmd.Flags().IntVar(&gotTimeout, "timeout", 60, "")
+	cmd.Flags().StringArrayVar(&gotWeights, "reviewer-weight", nil, "")
+
+	if err := cmd.Flags().Parse([]string{"--aggregator", "explicit"}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := applyProfile(cmd, config.Profile{
+		Models:          []string{"claude-sonnet-4.5", "gpt-5.2"},
+		Aggregator:      "from-profile",
+		Timeout:         120,
+		ReviewerWeights: map[string]float64{"gpt-5.2": 1.5},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(gotModels, []string{"claude-sonnet-4.5", "gpt-5.2"}) {
+		t.Errorf("models = %v, want the profile's models", gotModels)
+	}
+	if gotAggregator != "explicit" {
+		t.Errorf("aggregator = %q, explicit flag should win", gotAggregator)
+	}
+	if gotTimeout != 120 {
+		t.Errorf("timeout = %d, want 120", gotTimeout)
+	}
+	if !reflect.DeepEqual(gotWeights, []string{"gpt-5.2=1.5"}) {
+		t.Errorf("reviewer weights = %v", gotWeights)
+	}
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// rcFileName is the file of default flags looked up in the working
+// directory, then in the home directory
+const rcFileName = ".copilot-council"
+
+// rcForbidden lists the flags that run commands. An rc file may come with a
+// checkout the user does not trust, so these are only taken from the command line.
+var rcForbidden = map[string]bool{
+	"exec-model": true,
+	"pipe-to":    true,
+}
+
+// findRCFile returns the rc file that applies, or "" when there is none
+func findRCFile() string {
+	var dirs []string
+	if wd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, wd)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, home)
+	}
+	for _, dir := range dirs {
+		path := filepath.Join(dir, rcFileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// readRCFile reads default flags, one per line (e.g. "--timeout 120").
+// Blank lines and lines starting with # are ignored; values may be quoted.
+func readRCFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var args []string
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lineArgs, err := splitCommand(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNo, err)
+		}
+		if !strings.HasPrefix(lineArgs[0], "-") {
+			return nil, fmt.Errorf("%s:%d: expected a flag, got %q", path, lineNo, lineArgs[0])
+		}
+		args = append(args, lineArgs...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return args, nil
+}
+
+// withRCArgs puts the flags of the rc file at path in front of the command
+// line args, so that flags given explicitly win. Flags set on the command line
+// are dropped from the defaults entirely, so repeatable flags do not mix both
+// lists. Subcommands and --no-rc get args unchanged.
+func withRCArgs(cmd *cobra.Command, path string, args []string) ([]string, error) {
+	if target, _, err := cmd.Find(args); err == nil && target != cmd {
+		return args, nil
+	}
+	explicit := flagNames(cmd, args)
+	if path == "" || explicit["no-rc"] {
+		return args, nil
+	}
+	defaults, err := readRCFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept []string
+	for _, group := range flagGroups(cmd, defaults) {
+		name := flagName(cmd, group[0])
+		if rcForbidden[name] {
+			return nil, fmt.Errorf("%s: --%s runs a command and is not allowed in %s; pass it on the command line", path, name, rcFileName)
+		}
+		if !explicit[name] {
+			kept = append(kept, group...)
+		}
+	}
+	return append(kept, args...), nil
+}
+
+// flagNames returns the long names of the flags set in args
+func flagNames(cmd *cobra.Command, args []string) map[string]bool {
+	names := make(map[string]bool)
+	for _, group := range flagGroups(cmd, args) {
+		if strings.HasPrefix(group[0], "-") {
+			names[flagName(cmd, group[0])] = true
+		}
+	}
+	return names
+}
+
+// flagGroups splits args into one group per flag with its separate value,
+// if it takes one. Positional args form groups of their own; everything
+// after "--" is one final group.
+func flagGroups(cmd *cobra.Command, args []string) [][]string {
+	var groups [][]string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(groups, args[i:])
+		}
+		group := []string{arg}
+		if strings.HasPrefix(arg, "-") && !strings.Contains(arg, "=") && takesValue(cmd, flagName(cmd, arg)) && i+1 < len(args) {
+			i++
+			group = append(group, args[i])
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// flagName returns the long name of the flag arg sets, e.g. "aggregator" for
+// "-a" or "--aggregator=gpt-5.2"
+func flagName(cmd *cobra.Command, arg string) string {
+	name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+	if !strings.HasPrefix(arg, "--") && len(name) == 1 {
+		if flag := cmd.Flags().ShorthandLookup(name); flag != nil {
+			return flag.Name
+		}
+	}
+	return name
+}
+
+// takesValue reports whether the named flag needs a value when none is
+// attached with "="
+func takesValue(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		flag = cmd.PersistentFlags().Lookup(name)
+	}
+	return flag != nil && flag.NoOptDefVal == ""
+}
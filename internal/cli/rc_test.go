@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func rcTestCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "test", Args: cobra.ArbitraryArgs, Run: func(*cobra.Command, []string) {}}
+	cmd.Flags().StringSlice("models", nil, "")
+	cmd.Flags().StringP("aggregator", "a", "", "")
+	cmd.Flags().Int("timeout", 60, "")
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().Int("retry-empty", 0, "")
+	cmd.Flags().Lookup("retry-empty").NoOptDefVal = "2"
+	cmd.Flags().StringArray("exec-model", nil, "")
+	cmd.Flags().String("pipe-to", "", "")
+	cmd.PersistentFlags().Bool("no-rc", false, "")
+	cmd.AddCommand(&cobra.Command{Use: "models", Run: func(*cobra.Command, []string) {}})
+	return cmd
+}
+
+func writeRCFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), rcFileName)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadRCFile(t *testing.T) {
+	path := writeRCFile(t, "# team defaults\n\n--timeout 120\n  -a 'gpt-5.2'  \n--prompt-suffix \"Answer in English.\"\n--verbose\n")
+	args, err := readRCFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"--timeout", "120", "-a", "gpt-5.2", "--prompt-suffix", "Answer in English.", "--verbose"}
+	if strings.Join(args, "|") != strings.Join(want, "|") {
+		t.Errorf("readRCFile() = %q, want %q", args, want)
+	}
+
+	for _, bad := range []string{"What is Go?\n", "--prompt-suffix 'unterminated\n"} {
+		if _, err := readRCFile(writeRCFile(t, bad)); err == nil || !strings.Contains(err.Error(), ":1:") {
+			t.Errorf("readRCFile(%q) error = %v, want one naming line 1", bad, err)
+		}
+	}
+}
+
+func TestWithRCArgs(t *testing.T) {
+	path := writeRCFile(t, "--models gpt-5.2,claude-sonnet-4.5\n--aggregator gpt-5.2\n--timeout 90\n--retry-empty\n-v\n")
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "defaults go first",
+			args: []string{"What is Go?"},
+			want: []string{"--models", "gpt-5.2,claude-sonnet-4.5", "--aggregator", "gpt-5.2", "--timeout", "90", "--retry-empty", "-v", "What is Go?"},
+		},
+		{
+			name: "explicit flags replace defaults, also repeatable ones and shorthands",
+			args: []string{"--models=gpt-4.1", "-a", "auto", "--timeout", "30", "What is Go?"},
+			want: []string{"--retry-empty", "-v", "--models=gpt-4.1", "-a", "auto", "--timeout", "30", "What is Go?"},
+		},
+		{
+			name: "no-rc",
+			args: []string{"--no-rc", "What is Go?"},
+			want: []string{"--no-rc", "What is Go?"},
+		},
+		{
+			name: "subcommands are left alone",
+			args: []string{"models"},
+			want: []string{"models"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withRCArgs(rcTestCommand(), path, tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("withRCArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// The merged args parse, with the explicit values winning
+	cmd := rcTestCommand()
+	args, err := withRCArgs(cmd, path, []string{"--timeout", "30", "What is Go?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("merged args do not parse: %v", err)
+	}
+	if timeout, _ := cmd.Flags().GetInt("timeout"); timeout != 30 {
+		t.Errorf("timeout = %d, want the explicit 30", timeout)
+	}
+	if models, _ := cmd.Flags().GetStringSlice("models"); len(models) != 2 {
+		t.Errorf("models = %v, want the rc file's two", models)
+	}
+}
+
+func TestWithRCArgsRejectsCommands(t *testing.T) {
+	for _, line := range []string{
+		`--exec-model "pwned:touch /tmp/PWNED"`,
+		"--timeout 90 --pipe-to=sh",
+	} {
+		_, err := withRCArgs(rcTestCommand(), writeRCFile(t, line+"\n"), []string{"What is Go?"})
+		if err == nil || !strings.Contains(err.Error(), "runs a command and is not allowed in "+rcFileName) {
+			t.Errorf("withRCArgs() with %q: error = %v, want the flag rejected", line, err)
+		}
+	}
+
+	// The same flags are fine on the command line
+	path := writeRCFile(t, "--timeout 90\n")
+	if _, err := withRCArgs(rcTestCommand(), path, []string{"--exec-model", "local:echo hi", "What is Go?"}); err != nil {
+		t.Errorf("unexpected error for an explicit --exec-model: %v", err)
+	}
+}
+
+func TestFindRCFile(t *testing.T) {
+	home, work := t.TempDir(), t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(work)
+
+	if got := findRCFile(); got != "" {
+		t.Errorf("findRCFile() = %q, want none", got)
+	}
+	if err := os.WriteFile(filepath.Join(home, rcFileName), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := findRCFile(); got != filepath.Join(home, rcFileName) {
+		t.Errorf("findRCFile() = %q, want the home directory's", got)
+	}
+	if err := os.WriteFile(filepath.Join(work, rcFileName), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := findRCFile(); got != filepath.Join(work, rcFileName) {
+		t.Errorf("findRCFile() = %q, want the working directory's first", got)
+	}
+}
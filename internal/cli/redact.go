@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// redactedText replaces every sensitive match
+const redactedText = "[REDACTED]"
+
+// defaultRedactPatterns catch common secrets and personal data in pasted text
+var defaultRedactPatterns = []string{
+	`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`, // PEM private keys
+	`\bAKIA[0-9A-Z]{16}\b`,                                          // AWS access key IDs
+	`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_\w{22,})`,             // GitHub tokens
+	`\bsk-[A-Za-z0-9_-]{20,}`,                                       // OpenAI-style API keys
+	`\bxox[abprs]-[A-Za-z0-9-]{10,}`,                                // Slack tokens
+	`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{20,}=*`,                       // Bearer tokens
+	`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`,           // JWTs
+	`(?i)\b(api[_-]?key|secret|token|password|passwd)\s*[:=]\s*\S+`, // key=value secrets
+	`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`,            // Email addresses
+}
+
+// redactor replaces sensitive matches before text is sent to any model
+type redactor struct {
+	patterns []*regexp.Regexp
+	count    int // Redactions made so far
+}
+
+// newRedactor compiles the default patterns plus any custom ones
+func newRedactor(custom []string) (*redactor, error) {
+	r := &redactor{}
+	for _, pattern := range append(append([]string{}, defaultRedactPatterns...), custom...) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --redact-pattern %q: %w", pattern, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// redact replaces every match in text and counts the replacements
+func (r *redactor) redact(text string) string {
+	for _, re := range r.patterns {
+		text = re.ReplaceAllStringFunc(text, func(match string) string {
+			if match == redactedText {
+				return match
+			}
+			r.count++
+			return redactedText
+		})
+	}
+	return text
+}
+
+// redactHistory redacts the content of every message in place
+func (r *redactor) redactHistory(history []council.Message) {
+	for i := range history {
+		history[i].Content = r.redact(history[i].Content)
+	}
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+)
+
+// writeReports writes the JSON and Markdown renderings of a run to the
+// --also-json and --also-markdown paths; an empty path is skipped. It keeps
+// going when a file cannot be written and returns every error encountered.
+// With strict, the JSON report is checked against output.ReportSchema and
+// not written if it does not match.
+func writeReports(jsonPath, markdownPath string, strict bool, question string, result council.Result, totalDuration time.Duration) []error {
+	var errs []error
+	if jsonPath != "" {
+		data, err := output.RenderJSON(question, result, totalDuration)
+		if err == nil && strict {
+			err = output.ValidateReport(data)
+		}
+		if err == nil {
+			err = os.WriteFile(jsonPath, data, 0o644)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to write %s: %w", jsonPath, err))
+		}
+	}
+	if markdownPath != "" {
+		markdown := output.RenderMarkdown(question, result, totalDuration)
+		if err := os.WriteFile(markdownPath, []byte(markdown), 0o644); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write %s: %w", markdownPath, err))
+		}
+	}
+	return errs
+}
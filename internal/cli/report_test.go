@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestWriteReports(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "run.json")
+	markdownPath := filepath.Join(dir, "run.md")
+	result := council.Result{
+		ModelResponses:     []copilot.Response{{Model: "model-a", Content: "Answer A"}},
+		AggregatedResponse: "The synthesis",
+	}
+
+	if errs := writeReports(jsonPath, markdownPath, true, "What is Go?", result, time.Second); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report struct{ Answer string }
+	if err := json.Unmarshal(data, &report); err != nil || report.Answer != "The synthesis" {
+		t.Errorf("unexpected JSON report %q: %v", data, err)
+	}
+
+	data, err = os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "The synthesis") {
+		t.Errorf("unexpected Markdown report:\n%s", data)
+	}
+
+	// Unwritable paths are reported, one error per file
+	missing := filepath.Join(dir, "missing", "run")
+	if errs := writeReports(missing+".json", missing+".md", false, "What is Go?", result, time.Second); len(errs) != 2 {
+		t.Errorf("expected 2 errors, got %v", errs)
+	}
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// unsafeFilenameChars matches characters that are not portable in file names
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// sanitizeFilename turns a model or member name into a safe file name stem,
+// e.g. "openai/gpt-5:latest" becomes "openai_gpt-5_latest"
+func sanitizeFilename(name string) string {
+	stem := strings.TrimLeft(unsafeFilenameChars.ReplaceAllString(name, "_"), "._")
+	if stem == "" {
+		return "model"
+	}
+	return stem
+}
+
+// responsesIndex is dir/index.json: which file holds which member's response
+type responsesIndex struct {
+	Responses []indexEntry `json:"responses"`
+	Final     string       `json:"final,omitempty"`
+}
+
+// indexEntry describes one member's response; File is empty when the member
+// gave no answer
+type indexEntry struct {
+	Member          string  `json:"member"`
+	Model           string  `json:"model"`
+	File            string  `json:"file,omitempty"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// writeResponsesDir writes each successful response to dir/<member>.md, the
+// final answer to dir/final.md and an index of the files to dir/index.json.
+// It keeps going when a file cannot be written and returns every error
+// encountered.
+func writeResponsesDir(dir string, result council.Result) []error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return []error{fmt.Errorf("failed to create responses directory: %w", err)}
+	}
+
+	var errs []error
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(strings.TrimSpace(content)+"\n"), 0o644); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write %s: %w", path, err))
+		}
+	}
+
+	// final.md is reserved; colliding member names get a numeric suffix
+	used := map[string]bool{"final": true}
+	var index responsesIndex
+	for _, resp := range result.ModelResponses {
+		entry := indexEntry{
+			Member:          resp.Label(),
+			Model:           resp.Model,
+			DurationSeconds: resp.Duration.Round(time.Millisecond).Seconds(),
+		}
+		if resp.Error != nil {
+			entry.Error = resp.Error.Error()
+		}
+		if resp.Error == nil && resp.Content != "" {
+			stem := sanitizeFilename(resp.Label())
+			for i := 2; used[stem]; i++ {
+				stem = fmt.Sprintf("%s-%d", sanitizeFilename(resp.Label()), i)
+			}
+			used[stem] = true
+			entry.File = stem + ".md"
+			write(entry.File, resp.Content)
+		}
+		index.Responses = append(index.Responses, entry)
+	}
+
+	if result.AggregatedResponse != "" {
+		index.Final = "final.md"
+		write(index.Final, result.AggregatedResponse)
+	}
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return append(errs, fmt.Errorf("failed to encode the responses index: %w", err))
+	}
+	write("index.json", string(data))
+	return errs
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestSanitizeFilename(t *testing.T) {
+	tests := map[string]string{
+		"gpt-5.2":             "gpt-5.2",
+		"openai/gpt-5:latest": "openai_gpt-5_latest",
+		"../etc/passwd":       "etc_passwd",
+		"skeptic persona":     "skeptic_persona",
+		"///":                 "model",
+	}
+	for name, want := range tests {
+		if got := sanitizeFilename(name); got != want {
+			t.Errorf("sanitizeFilename(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestWriteResponsesDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "responses")
+	result := council.Result{
+		ModelResponses: []copilot.Response{
+			{Model: "claude-sonnet-4.5", Content: "Answer A"},
+			{Model: "provider/gpt:5", Content: "Answer B"},
+			{Model: "provider_gpt_5", Content: "Answer C", Duration: 1500 * time.Millisecond},
+			{Model: "broken", Error: errors.New("timeout")},
+			{Member: "final", Model: "gpt-5.2", Content: "Persona answer"},
+		},
+		AggregatedResponse: "The synthesis",
+	}
+
+	if errs := writeResponsesDir(dir, result); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	want := []string{"claude-sonnet-4.5.md", "final-2.md", "final.md", "index.json", "provider_gpt_5-2.md", "provider_gpt_5.md"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("files = %v, want %v", names, want)
+	}
+
+	for name, content := range map[string]string{
+		"claude-sonnet-4.5.md": "Answer A\n",
+		"provider_gpt_5.md":    "Answer B\n",
+		"provider_gpt_5-2.md":  "Answer C\n",
+		"final-2.md":           "Persona answer\n",
+		"final.md":             "The synthesis\n",
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(data) != content {
+			t.Errorf("%s: got %q, %v; want %q", name, data, err, content)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var index responsesIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		t.Fatalf("index.json is not valid JSON: %v", err)
+	}
+	if index.Final != "final.md" || len(index.Responses) != len(result.ModelResponses) {
+		t.Fatalf("index = %+v", index)
+	}
+	if got := index.Responses[2]; got.Model != "provider_gpt_5" || got.File != "provider_gpt_5-2.md" || got.DurationSeconds != 1.5 {
+		t.Errorf("index entry = %+v", got)
+	}
+	if got := index.Responses[3]; got.File != "" || got.Error != "timeout" {
+		t.Errorf("failed member's entry = %+v", got)
+	}
+	if got := index.Responses[4]; got.Member != "final" || got.Model != "gpt-5.2" || got.File != "final-2.md" {
+		t.Errorf("persona entry = %+v", got)
+	}
+}
+
+func TestWriteResponsesDirReportsErrors(t *testing.T) {
+	// A regular file where the directory should be cannot be written into
+	path := filepath.Join(t.TempDir(), "taken")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	errs := writeResponsesDir(path, council.Result{AggregatedResponse: "x"})
+	if len(errs) == 0 {
+		t.Error("expected an error when the directory cannot be created")
+	}
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+)
+
+// stageOneEntry is the on-disk layout of the members' answers to one
+// question, kept for --reuse-responses
+type stageOneEntry struct {
+	Question  string           `json:"question"`
+	Members   []string         `json:"members"`
+	Created   time.Time        `json:"created"`
+	Responses []stageOneAnswer `json:"responses"`
+}
+
+// stageOneAnswer is one member's stored answer
+type stageOneAnswer struct {
+	Member          string  `json:"member"`
+	Model           string  `json:"model"`
+	Content         string  `json:"content"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Partial         bool    `json:"partial,omitempty"`
+}
+
+// stageOneStore keeps the answers of stage 1 by question, so that a later
+// run can go straight to peer review and aggregation
+type stageOneStore struct {
+	dir      string
+	question string   // The question with its prompt prefix and suffix
+	members  []string // Members that answer in stage 1, by label
+}
+
+// key names the entry of the question; the members are checked on load
+// instead, so that a changed council is reported rather than missed
+func (s *stageOneStore) key() string {
+	sum := sha256.Sum256([]byte(s.question))
+	return hex.EncodeToString(sum[:])
+}
+
+// Load returns the stored answers in member order and when they were
+// stored. It returns no answers without an entry for the question, and an
+// error when the entry is unreadable or was made by another set of members.
+func (s *stageOneStore) Load() ([]copilot.Response, time.Time, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, s.key()+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read cached responses: %w", err)
+	}
+	var entry stageOneEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read cached responses: %w", err)
+	}
+	if !sameMembers(entry.Members, s.members) {
+		return nil, entry.Created, fmt.Errorf("cached responses are from %s, not %s", strings.Join(entry.Members, ", "), strings.Join(s.members, ", "))
+	}
+
+	byMember := make(map[string]stageOneAnswer, len(entry.Responses))
+	for _, answer := range entry.Responses {
+		byMember[answer.Member] = answer
+	}
+	responses := make([]copilot.Response, len(s.members))
+	for i, member := range s.members {
+		answer, ok := byMember[member]
+		if !ok {
+			return nil, entry.Created, fmt.Errorf("cached responses have no answer from %s", member)
+		}
+		responses[i] = copilot.Response{
+			Member:   answer.Member,
+			Model:    answer.Model,
+			Content:  answer.Content,
+			Duration: time.Duration(answer.DurationSeconds * float64(time.Second)),
+			Partial:  answer.Partial,
+		}
+	}
+	return responses, entry.Created, nil
+}
+
+// Store saves the stage 1 answers of the members. It reports false, storing
+// nothing, unless every member answered.
+func (s *stageOneStore) Store(responses []copilot.Response) (bool, error) {
+	entry := stageOneEntry{Question: s.question, Members: s.members, Created: time.Now()}
+	byMember := make(map[string]copilot.Response, len(responses))
+	for _, resp := range responses {
+		byMember[resp.Label()] = resp
+	}
+	for _, member := range s.members {
+		resp, ok := byMember[member]
+		if !ok || resp.Error != nil || resp.Content == "" {
+			return false, nil
+		}
+		entry.Responses = append(entry.Responses, stageOneAnswer{
+			Member:          resp.Label(),
+			Model:           resp.Model,
+			Content:         resp.Content,
+			DurationSeconds: resp.Duration.Seconds(),
+			Partial:         resp.Partial,
+		})
+	}
+
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return false, fmt.Errorf("failed to encode cached responses: %w", err)
+	}
+	if err := writeCacheFile(s.dir, s.key(), data); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// sameMembers reports whether a and b hold the same members, in any order
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// storeStageOne keeps the stage 1 answers of result for --reuse-responses
+func storeStageOne(printer *output.Printer, result council.Result) {
+	responses := result.ModelResponses
+	if len(result.InitialResponses) > 0 {
+		responses = result.InitialResponses // Roundtable answers before revision
+	}
+	stored, err := stageOne.Store(responses)
+	switch {
+	case err != nil:
+		printer.PrintWarning("could not store the answers for --reuse-responses: %v", err)
+	case !stored:
+		printer.PrintVerbose("Not every member answered; answers not stored for --reuse-responses")
+	}
+}
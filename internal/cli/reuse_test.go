@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+func TestStageOneStore(t *testing.T) {
+	dir := t.TempDir()
+	store := &stageOneStore{dir: dir, question: "What is Go?", members: []string{"model-a", "model-b"}}
+
+	if responses, _, err := store.Load(); err != nil || responses != nil {
+		t.Fatalf("expected no answers before the first run, got %v, %v", responses, err)
+	}
+
+	answers := []copilot.Response{
+		{Model: "model-b", Content: "Answer B"},
+		{Model: "model-a", Content: "Answer A", Partial: true},
+	}
+	if stored, err := store.Store(answers); err != nil || !stored {
+		t.Fatalf("Store = %v, %v", stored, err)
+	}
+	responses, created, err := store.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.IsZero() || len(responses) != 2 || responses[0].Content != "Answer A" || !responses[0].Partial || responses[1].Content != "Answer B" {
+		t.Errorf("unexpected answers, stored %v: %+v", created, responses)
+	}
+
+	// Another question has no entry
+	other := &stageOneStore{dir: dir, question: "What is Rust?", members: store.members}
+	if responses, _, err := other.Load(); err != nil || responses != nil {
+		t.Errorf("expected no answers for another question, got %v, %v", responses, err)
+	}
+
+	// The same question to another council is not reused
+	changed := &stageOneStore{dir: dir, question: store.question, members: []string{"model-a", "model-c"}}
+	if _, _, err := changed.Load(); err == nil || !strings.Contains(err.Error(), "model-a, model-b") {
+		t.Errorf("expected a member mismatch error, got %v", err)
+	}
+}
+
+func TestStageOneStoreIncomplete(t *testing.T) {
+	store := &stageOneStore{dir: t.TempDir(), question: "What is Go?", members: []string{"model-a", "model-b"}}
+
+	stored, err := store.Store([]copilot.Response{
+		{Model: "model-a", Content: "Answer A"},
+		{Model: "model-b", Error: context.DeadlineExceeded},
+	})
+	if err != nil || stored {
+		t.Errorf("expected answers with a failure not to be stored, got %v, %v", stored, err)
+	}
+	if responses, _, _ := store.Load(); responses != nil {
+		t.Errorf("expected nothing stored, got %+v", responses)
+	}
+}
@@ -2,10 +2,15 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/fatih/color"
+	"github.com/openjny/council/internal/config"
+	"github.com/openjny/council/internal/copilot"
 	"github.com/openjny/council/internal/council"
 	"github.com/openjny/council/internal/output"
 	"github.com/spf13/cobra"
@@ -16,6 +21,165 @@ var (
 	aggregator string
 	timeout    int
 	verbose    bool
+
+	promptPrefix string
+	promptSuffix string
+
+	maxReviewPairs int
+	warnOnEcho     bool
+
+	pipeTo     string
+	pipeStrict bool
+
+	modelsFile string
+
+	mock        bool
+	mockFixture string
+
+	reviewerWeights []string
+	reviewQuorum    int
+	tracePrompts    bool
+	splitReasoning  bool
+	retryEmpty      int
+
+	quiet             bool
+	answerOnly        bool
+	noTrailingNewline bool
+	noColor           bool
+
+	profile      string
+	profilesFile string
+
+	personas []string
+
+	maxReviewers int
+	reviewerSeed int64
+
+	requireAllModels bool
+
+	responsesDir string
+
+	examplesFile string
+
+	reviewLabels string
+
+	smartAggregate bool
+
+	refineRounds int
+
+	modelOptions []string
+
+	theme string
+
+	dissent bool
+
+	lang string
+
+	warmup bool
+
+	diff bool
+
+	mode string
+
+	chairmanPersona string
+
+	answerLength   string
+	maxAnswerWords int
+
+	noBanner bool
+
+	noReviewFrom []string
+
+	compareStrategies bool
+
+	redact         bool
+	redactPatterns []string
+
+	watchFile string
+
+	cacheAggregation bool
+	cacheTTL         time.Duration
+	noCache          bool
+	cacheResponses   bool
+	offline          bool
+
+	multiPrompt   string
+	metaAggregate bool
+
+	onlyModels    []string
+	excludeModels []string
+
+	maxOutputLines int
+
+	includePartial bool
+
+	weightByLatency bool
+	maxConcurrency  int
+
+	execModelSpecs []string
+
+	jsonFooter bool
+
+	detectRefusals bool
+
+	alsoJSON     string
+	alsoMarkdown string
+
+	proposerMode bool
+
+	reviewAnonymize bool
+
+	autoOrder bool
+
+	usePager bool
+
+	strictJSON bool
+
+	aggregatorContext string
+
+	contextWindows []string
+
+	aggregationStrategy string
+
+	collectSources bool
+
+	minResponseLength string
+
+	configJSON string
+
+	noRC   bool
+	rcFile string // The rc file whose default flags were applied, if any
+
+	ciFormat string
+
+	minDistinct  int
+	distinctPool []string
+
+	qualityGatedReview bool
+	reviewerMinWords   int
+
+	eventsFile string
+
+	verifyQuotes bool
+
+	reuseResponses bool
+	stageOne       *stageOneStore // Where --reuse-responses keeps stage 1 answers
+
+	csvAppend string
+
+	singleSuccessFallback bool
+
+	outputSchemaFile string
+
+	fromClipboard bool
+
+	analyzeResponses bool
+
+	tiebreaker string
+
+	minReviewLength string
+
+	framing string
 )
 
 var rootCmd = &cobra.Command{
@@ -24,7 +188,14 @@ var rootCmd = &cobra.Command{
 	Long: `Copilot Council is a CLI tool that implements the "Council Pattern".
 It asks the same question to multiple AI models (Claude, GPT, Gemini) in parallel,
 then aggregates their responses using another model to produce a final synthesized answer.`,
-	Args: cobra.ExactArgs(1),
+	Args: func(cmd *cobra.Command, args []string) error {
+		// Watch and multi-prompt modes read their questions from a file,
+		// and --from-clipboard from the clipboard
+		if watchFile != "" || multiPrompt != "" || fromClipboard {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	RunE: run,
 	Example: `  # Ask a question using default models
   copilot-council "What is the capital of France?"
@@ -43,79 +214,833 @@ func init() {
 	rootCmd.Flags().StringSliceVarP(&models, "models", "m", council.DefaultModels(),
 		"Comma-separated list of models to consult")
 	rootCmd.Flags().StringVarP(&aggregator, "aggregator", "a", council.DefaultAggregator(),
-		"Model to use for aggregating responses")
+		"Model to use for aggregating responses (\"auto\" picks the top-ranked council member, \"none\" = --mode gather)")
 	rootCmd.Flags().IntVarP(&timeout, "timeout", "t", 60,
 		"Timeout in seconds for each model request")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false,
 		"Enable verbose output")
+	rootCmd.Flags().StringVar(&promptPrefix, "prompt-prefix", "",
+		"Text prepended to the question sent to each model")
+	rootCmd.Flags().StringVar(&promptSuffix, "prompt-suffix", "",
+		"Text appended to the question sent to each model")
+	rootCmd.Flags().IntVar(&maxReviewPairs, "max-review-pairs", 0,
+		"Maximum number of (reviewer, reviewed) evaluations in peer review (0 = no limit)")
+	rootCmd.Flags().BoolVar(&warnOnEcho, "warn-on-echo", false,
+		"Warn prominently when the final answer closely matches a single model's response")
+	rootCmd.Flags().StringVar(&pipeTo, "pipe-to", "",
+		"Command to receive the final answer on stdin (e.g. \"pbcopy\" or \"tee answer.md\")")
+	rootCmd.Flags().BoolVar(&pipeStrict, "pipe-strict", false,
+		"Fail the run if the --pipe-to command fails (default: warn only)")
+	rootCmd.Flags().StringVar(&modelsFile, "models-file", "",
+		"File listing models to consult, one per line (replaces the defaults; merged after an explicit --models)")
+	rootCmd.Flags().BoolVar(&mock, "mock", os.Getenv("COPILOT_COUNCIL_MOCK") == "1",
+		"Use a deterministic offline model backend (env: COPILOT_COUNCIL_MOCK=1)")
+	rootCmd.Flags().StringVar(&mockFixture, "mock-fixture", os.Getenv("COPILOT_COUNCIL_MOCK_FIXTURE"),
+		"JSON fixture scripting mock responses (implies --mock)")
+	rootCmd.Flags().StringArrayVar(&reviewerWeights, "reviewer-weight", nil,
+		"Weight of a reviewer's rankings in the consensus as model=weight (repeatable, default 1)")
+	rootCmd.Flags().IntVar(&reviewQuorum, "review-quorum", 0,
+		"Stop peer review once this many reviewers agree on the best response (0 = wait for all)")
+	rootCmd.Flags().BoolVar(&tracePrompts, "trace-prompts", false,
+		"Print each prompt to stderr right before it is sent")
+	rootCmd.Flags().BoolVar(&splitReasoning, "split-reasoning", false,
+		"Ask the aggregator to separate its reasoning from the final answer (reasoning shown with --verbose)")
+	rootCmd.Flags().IntVar(&retryEmpty, "retry-empty", 0,
+		"Re-ask a model that returned an empty response up to N times (--retry-empty alone = 2)")
+	rootCmd.Flags().Lookup("retry-empty").NoOptDefVal = "2"
+	rootCmd.Flags().BoolVarP(&quiet, "quiet", "q", false,
+		"Print only the final answer to stdout; progress, warnings and errors go to stderr")
+	rootCmd.Flags().BoolVar(&answerOnly, "answer-only", false,
+		"Print exactly the final answer and nothing else on stdout; progress and errors go to stderr (implies --quiet --no-color)")
+	rootCmd.Flags().BoolVar(&noTrailingNewline, "no-trailing-newline", false,
+		"Omit the newline after the answer in --quiet/--answer-only mode")
+	rootCmd.Flags().BoolVar(&noColor, "no-color", false,
+		"Disable colored output (also honors NO_COLOR)")
+	rootCmd.Flags().StringVarP(&profile, "profile", "p", "",
+		"Named council profile to use; explicit flags override its settings")
+	rootCmd.PersistentFlags().BoolVar(&noRC, "no-rc", false,
+		"Ignore the default flags in "+rcFileName+" (working directory, then home)")
+	rootCmd.PersistentFlags().StringVar(&profilesFile, "profiles-file", "",
+		"Profiles file (YAML or JSON; default: "+config.DefaultProfilesPath()+")")
+	rootCmd.Flags().StringArrayVar(&personas, "personas", nil,
+		"Council member as name=system-prompt, run on the first --models entry (repeatable; name@model=... picks the model)")
+	rootCmd.Flags().IntVar(&maxReviewers, "max-reviewers", 0,
+		"Let only a random subset of M members review (0 = every member reviews)")
+	rootCmd.Flags().Int64Var(&reviewerSeed, "reviewer-seed", 0,
+		"Seed for the --max-reviewers selection (0 = random each run)")
+	rootCmd.Flags().BoolVar(&requireAllModels, "require-all-models", false,
+		"Fail the run if any requested model fails to respond (default: continue with the rest)")
+	rootCmd.Flags().StringVar(&responsesDir, "responses-dir", "",
+		"Directory to write each model's response (<model>.md), the final answer (final.md) and an index of them (index.json)")
+	rootCmd.Flags().StringVar(&examplesFile, "examples", "",
+		"JSON file of prior turns or few-shot examples ([{\"role\": \"user\", \"content\": \"...\"}, ...]) sent before the question")
+	rootCmd.Flags().StringVar(&reviewLabels, "review-labels", council.LabelsAlpha,
+		"Labels for anonymized responses in peer review: "+strings.Join(council.LabelSchemes, "|"))
+	rootCmd.Flags().BoolVar(&smartAggregate, "smart-aggregate", false,
+		"Skip the aggregator when every model gave essentially the same answer")
+	rootCmd.Flags().IntVar(&refineRounds, "refine-rounds", 0,
+		fmt.Sprintf("Feed the synthesis back to the council for N more rounds of regeneration (max %d)", council.MaxRefineRounds))
+	rootCmd.Flags().StringArrayVar(&modelOptions, "model-option", nil,
+		"Per-model message option as model:key=value (repeatable; keys: "+strings.Join(copilot.SendOptionKeys, ", ")+")")
+	rootCmd.Flags().StringVar(&theme, "theme", output.ThemeDark,
+		"Color theme: "+strings.Join(output.Themes, "|")+" (mono = --no-color)")
+	rootCmd.Flags().BoolVar(&dissent, "dissent", false,
+		"After the final answer, ask each model where it disagrees (one extra request per model)")
+	rootCmd.Flags().StringVar(&lang, "lang", "",
+		"Language of the tool's own output: "+strings.Join(output.Languages, "|")+" (default: detected from LANG; model answers are not translated)")
+	rootCmd.Flags().BoolVar(&warmup, "warmup", false,
+		"Create every model session before sending the question, so response times measure inference only")
+	rootCmd.Flags().BoolVar(&diff, "diff", false,
+		"A/B mode for exactly two models: show a word diff of their answers instead of reviewing and synthesizing")
+	rootCmd.Flags().StringVar(&mode, "mode", council.ModeCouncil,
+		"How members work together: "+strings.Join(council.Modes, "|")+" (roundtable = revise after reading each other's answers, instead of ranking them; proposer = the others critique the first model's answer; gather = show the answers and their ranking without a synthesis)")
+	rootCmd.Flags().StringVar(&chairmanPersona, "chairman-persona", council.ChairmanDecisive,
+		"How the aggregator synthesizes: "+strings.Join(council.ChairmanPersonas, "|")+" (balanced = present viewpoints instead of one answer)")
+	rootCmd.Flags().StringVar(&answerLength, "answer-length", "",
+		"Ask the aggregator for a "+strings.Join(council.AnswerLengths, "|")+" answer (advisory)")
+	rootCmd.Flags().IntVar(&maxAnswerWords, "max-answer-words", 0,
+		"Cap the final answer at N words, trimming at a sentence boundary if needed (0 = no cap)")
+	rootCmd.Flags().BoolVar(&noBanner, "no-banner", false,
+		"Do not print the banner and the echoed question (implied by --quiet and --answer-only)")
+	rootCmd.Flags().StringSliceVar(&noReviewFrom, "no-review-from", nil,
+		"Models or personas whose answers are reviewed and synthesized, but who do not review others (comma-separated, repeatable)")
+	rootCmd.Flags().BoolVar(&compareStrategies, "compare-strategies", false,
+		"Also show the answer picked by vote and by a judge, next to the chairman's synthesis ("+strings.Join(council.Strategies, ", ")+")")
+	rootCmd.Flags().BoolVar(&redact, "redact", false,
+		"Replace API keys, tokens, emails and similar secrets with [REDACTED] in the question, prompt prefix/suffix and examples before sending")
+	rootCmd.Flags().StringArrayVar(&redactPatterns, "redact-pattern", nil,
+		"Extra regular expression to redact, on top of the defaults (repeatable; implies --redact)")
+	rootCmd.Flags().StringVar(&watchFile, "watch", "",
+		"Read the question from a file and re-run the council every time the file is saved, until Ctrl-C")
+	rootCmd.Flags().BoolVar(&cacheAggregation, "cache-aggregation", false,
+		"Reuse the synthesis of an earlier run when the aggregator would get exactly the same answers, reviews and instructions")
+	rootCmd.Flags().DurationVar(&cacheTTL, "cache-ttl", 24*time.Hour,
+		"How long a cached synthesis stays valid (0 = forever)")
+	rootCmd.Flags().BoolVar(&noCache, "no-cache", false,
+		"Neither read nor write cached syntheses, even if --cache-aggregation is set (e.g. by a profile)")
+	rootCmd.Flags().BoolVar(&cacheResponses, "cache-responses", false,
+		"Store every model answer and reuse it when the same member gets exactly the same prompt again")
+	rootCmd.Flags().BoolVar(&offline, "offline", false,
+		"Never call a model: answer only from responses stored by --cache-responses, and fail naming any that are missing")
+	rootCmd.Flags().StringVar(&multiPrompt, "multi-prompt", "",
+		"JSON file with an array of sub-questions; the council answers each in turn")
+	rootCmd.Flags().BoolVar(&metaAggregate, "meta-aggregate", false,
+		"With --multi-prompt, merge the answers into one document with a final aggregation call")
+	rootCmd.Flags().StringSliceVar(&onlyModels, "only-models", nil,
+		"Use only these of the configured models (comma-separated; each must be configured)")
+	rootCmd.Flags().StringSliceVar(&excludeModels, "exclude-models", nil,
+		"Leave these of the configured models out (comma-separated; each must be configured)")
+	rootCmd.Flags().IntVar(&maxOutputLines, "max-output-lines", 0,
+		"Cut each member response printed on screen after N display lines; --verbose prints them in full (0 = no limit; the council still uses the full text)")
+	rootCmd.Flags().BoolVar(&includePartial, "include-partial", false,
+		"Review and aggregate answers that broke off mid-stream instead of counting them as failed")
+	rootCmd.Flags().BoolVar(&weightByLatency, "models-weight-by-latency", false,
+		"Launch the models that answered fastest in past runs first (response times are kept in the user cache directory)")
+	rootCmd.Flags().IntVar(&maxConcurrency, "max-concurrency", 0,
+		"Ask at most N models at once; the rest wait for a free slot, fastest first with --models-weight-by-latency (0 = no limit)")
+	rootCmd.Flags().StringArrayVar(&execModelSpecs, "exec-model", nil,
+		"Seat a pseudo-model answered by a command, as name:command; the prompt goes to its stdin and its stdout is the answer (repeatable)")
+	rootCmd.Flags().BoolVar(&jsonFooter, "json-footer", false,
+		"End the output with one line of run stats as JSON, prefixed with \""+output.FooterPrefix+"\"")
+	rootCmd.Flags().BoolVar(&detectRefusals, "detect-refusals", false,
+		"Flag answers that decline to help (\"I can't help with that\"), keep them from being top-ranked and tell the chairman")
+	rootCmd.Flags().StringVar(&alsoJSON, "also-json", "",
+		"Also write the run (answer, responses, reviews, stats) as JSON to this file")
+	rootCmd.Flags().StringVar(&alsoMarkdown, "also-markdown", "",
+		"Also write the run as a Markdown document to this file")
+	rootCmd.Flags().BoolVar(&proposerMode, "proposer-mode", false,
+		"Shorthand for --mode proposer: the first model proposes an answer, the others critique it, then the chairman synthesizes")
+	rootCmd.Flags().BoolVar(&reviewAnonymize, "review-anonymize", true,
+		"Hide who wrote each response from peer reviewers; =false labels responses with their model names")
+	rootCmd.Flags().BoolVar(&autoOrder, "auto-order", false,
+		"Reorder the models by their average response time in past runs, fastest first (kept in the user cache directory)")
+	rootCmd.Flags().BoolVar(&usePager, "pager", false,
+		"Show the output through $PAGER (default \""+output.DefaultPager+"\") when it does not fit on the screen; ignored when piped, with --quiet and with --watch")
+	rootCmd.Flags().BoolVar(&strictJSON, "strict-json", false,
+		"Count reviews without a parseable ranking as failed, and fail the run if the --also-json report does not match its schema")
+	rootCmd.Flags().StringVar(&aggregatorContext, "aggregator-context", "",
+		"File with guidance (a rubric, a house style) shown only to the chairman at synthesis, never to members or reviewers")
+	rootCmd.Flags().StringArrayVar(&contextWindows, "context-window", nil,
+		"Context window of a model in tokens, as model=tokens; an aggregation prompt estimated to exceed it is shrunk before sending (repeatable)")
+	rootCmd.Flags().StringVar(&aggregationStrategy, "aggregation-strategy", council.AggregationSingle,
+		"How the chairman synthesizes: "+strings.Join(council.AggregationStrategies, "|")+" (mapreduce = summarize groups of responses first, for large councils)")
+	rootCmd.Flags().BoolVar(&collectSources, "collect-sources", false,
+		"Gather the URLs, DOIs and arXiv IDs the models cite, ask the chairman to cite them, and list them under the answer")
+	rootCmd.Flags().StringVar(&minResponseLength, "min-response-length", "0",
+		"Count answers shorter than N characters (or Nw words) as failed with \"insufficient content\", leaving them out of review and aggregation")
+	rootCmd.Flags().StringVar(&configJSON, "config-json", "",
+		"Council settings as one JSON object with the fields of a profile (models, aggregator, mode, timeout, ...); below explicit flags and --profile")
+	rootCmd.Flags().StringVar(&ciFormat, "ci-format", "",
+		"Mark up progress for a CI log: github (collapsible group per phase, annotations for each model; the default when GITHUB_ACTIONS=true) or none")
+	rootCmd.Flags().IntVar(&minDistinct, "min-distinct", 0,
+		"Ask more models until the answers hold at least N distinct viewpoints (clusters of similar answers), or the pool runs out (0 = off)")
+	rootCmd.Flags().StringSliceVar(&distinctPool, "distinct-pool", nil,
+		"Models --min-distinct may add, in order (default: every model available to your subscription)")
+	rootCmd.Flags().BoolVar(&qualityGatedReview, "quality-gated-review", false,
+		"Only members whose own answer has at least --reviewer-min-words words (and is no refusal) review the others; everyone is still reviewed")
+	rootCmd.Flags().IntVar(&reviewerMinWords, "reviewer-min-words", 40,
+		"With --quality-gated-review, the words a member's answer needs for it to review")
+	rootCmd.Flags().StringVar(&eventsFile, "events-file", "",
+		"Also write every progress event to this file as NDJSON while the output renders normally, for another process to tail")
+	rootCmd.Flags().BoolVar(&verifyQuotes, "verify-quotes", false,
+		"After the synthesis, have the aggregator check each factual claim against the members' answers and mark unsupported ones inline (one extra request)")
+	rootCmd.Flags().BoolVar(&reuseResponses, "reuse-responses", false,
+		"Keep the members' answers to this question, and on later runs with the same question and members skip straight to peer review and aggregation")
+	rootCmd.Flags().StringVar(&csvAppend, "csv-append", "",
+		"Append a row of timings per run to this CSV file (header written when new), for benchmarking in a spreadsheet")
+	rootCmd.Flags().BoolVar(&singleSuccessFallback, "no-aggregation-on-single-success", true,
+		"When only one member answers, use its answer as the final answer without peer review or aggregation; =false still runs them")
+	rootCmd.Flags().StringVar(&outputSchemaFile, "output-schema", "",
+		"JSON Schema file for an object: every member answers with matching JSON, and the answers are merged by majority vote per field instead of by the chairman")
+	rootCmd.Flags().BoolVar(&fromClipboard, "from-clipboard", false,
+		"Ask the text on the system clipboard instead of a question argument (pbpaste, wl-paste, xclip/xsel or PowerShell)")
+	rootCmd.Flags().BoolVar(&analyzeResponses, "analyze-responses", false,
+		"Annotate each member's answer with its word count, detected language and a crude sentiment label (verbose output and JSON)")
+	rootCmd.Flags().StringVar(&tiebreaker, "tiebreaker", "",
+		"Model to ask for a head-to-head comparison when peer review leaves the top two responses tied; its pick ranks first (one extra call, only on a tie)")
+	rootCmd.Flags().StringVar(&minReviewLength, "min-review-length", "0",
+		"Skip peer review when every successful answer is shorter than N characters (or Nw words), and go straight to synthesis")
+	rootCmd.Flags().StringVar(&framing, "framing", council.FramingCouncil,
+		"Wording of the prompts and labels: "+strings.Join(council.Framings, "|")+" (neutral = a synthesizer, evaluators and candidate answers instead of an AI Council and its Chairman)")
+	_ = rootCmd.Flags().MarkHidden("mock")
+	_ = rootCmd.Flags().MarkHidden("mock-fixture")
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	question := args[0]
-	printer := output.NewPrinter(verbose)
+	var question string
+	if len(args) > 0 {
+		question = args[0]
+	}
+
+	if answerOnly {
+		quiet = true
+		noColor = true
+	}
+	if noColor {
+		color.NoColor = true
+	}
+	if err := output.ApplyTheme(theme); err != nil {
+		return err
+	}
+	outputLang, err := output.DetectLanguage(lang)
+	if err != nil {
+		return err
+	}
+	ciLog, err := output.ResolveCIFormat(ciFormat)
+	if err != nil {
+		return err
+	}
+
+	printer := output.NewPrinterWithOptions(output.Options{
+		Verbose:           verbose,
+		Quiet:             quiet,
+		NoTrailingNewline: noTrailingNewline,
+		Lang:              outputLang,
+		NoBanner:          noBanner,
+		MaxOutputLines:    maxOutputLines,
+		CIFormat:          ciLog,
+		Timeout:           time.Duration(timeout) * time.Second,
+		AnalyzeResponses:  analyzeResponses,
+		Framing:           framing,
+	})
+
+	if watchFile != "" && multiPrompt != "" {
+		err := fmt.Errorf("--watch and --multi-prompt cannot be used together")
+		printer.PrintError(err)
+		return err
+	}
+
+	// Hold the output back and page it once the run is done; watch mode
+	// keeps redrawing the screen instead
+	if usePager && watchFile == "" {
+		printer.StartPaging()
+		defer func() {
+			if err := printer.FlushPager(output.PagerCommand()); err != nil {
+				printer.PrintWarning("%v", err)
+			}
+		}()
+	}
+
+	if metaAggregate && multiPrompt == "" {
+		err := fmt.Errorf("--meta-aggregate requires --multi-prompt")
+		printer.PrintError(err)
+		return err
+	}
+
+	// Multi-prompt mode takes its questions from a file
+	var questions []string
+	if multiPrompt != "" {
+		if questions, err = readMultiPromptFile(multiPrompt); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+
+	if fromClipboard {
+		if watchFile != "" || multiPrompt != "" {
+			err := fmt.Errorf("--from-clipboard cannot be combined with --watch or --multi-prompt")
+			printer.PrintError(err)
+			return err
+		}
+		if question, err = readClipboardQuestion(clipboard); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+
+	// Watch mode takes the question from the prompt file
+	if watchFile != "" {
+		if question, err = readPromptFile(watchFile); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
 
 	// Print banner
 	printer.PrintBanner()
-	printer.PrintQuestion(question)
+	if multiPrompt != "" {
+		printer.PrintQuestion(fmt.Sprintf("%d questions from %s", len(questions), multiPrompt))
+	} else {
+		printer.PrintQuestion(question)
+	}
+
+	if rcFile != "" && !noRC {
+		printer.PrintVerbose("Default flags from %s", rcFile)
+	}
+
+	// Apply the selected profile beneath any explicit flags, and the inline
+	// configuration beneath both. Profiles mark the flags they set as
+	// changed, so whether --models was typed is recorded first.
+	typedModels := cmd.Flags().Changed("models")
+	if profile != "" {
+		if err := useProfile(cmd, profile); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+	if configJSON != "" {
+		p, err := config.ParseProfileJSON(configJSON)
+		if err == nil {
+			err = applyProfile(cmd, p)
+		}
+		if err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+
+	// Load models from file
+	if modelsFile != "" {
+		var err error
+		models, err = mergeModelsFile(models, typedModels, modelsFile)
+		if err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+
+	// Seat the exec-backed pseudo-models next to the Copilot ones
+	var execModels []council.ExecModel
+	for _, spec := range execModelSpecs {
+		execModel, err := parseExecModel(spec)
+		if err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		execModels = append(execModels, execModel)
+		models = append(models, execModel.Name)
+	}
+
+	// Drop repeated models; each council seat must be a distinct model
+	var duplicates []string
+	models, duplicates = council.DedupeModels(models)
+	if len(duplicates) > 0 {
+		printer.PrintWarning("ignoring duplicate models: %s", strings.Join(duplicates, ", "))
+	}
+
+	// Narrow the configured models down for this run
+	if len(onlyModels) > 0 || len(excludeModels) > 0 {
+		var err error
+		if models, err = filterModels(models, onlyModels, excludeModels); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		printer.PrintVerbose("Models: %s", strings.Join(models, ", "))
+	}
+
+	// Without history yet, models keep their configured order
+	var latencies map[string]time.Duration
+	if weightByLatency || autoOrder {
+		if path, err := defaultLatencyFile(); err != nil {
+			printer.PrintWarning("%v", err)
+		} else if latencies, err = loadLatencies(path); err != nil {
+			printer.PrintWarning("%v", err)
+		}
+	}
+	if autoOrder && len(latencies) > 0 {
+		models = council.OrderByLatency(models, latencies)
+		printer.PrintVerbose("Models by past latency: %s", strings.Join(models, ", "))
+	}
 
 	// Validate models
 	if len(models) == 0 {
 		return fmt.Errorf("at least one model must be specified")
 	}
 
+	// Personas replace the model list with one seat per persona
+	members := copilot.ModelMembers(models)
+	if len(personas) > 0 {
+		var err error
+		if members, err = parsePersonas(personas, models[0]); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+
+	var guidance string
+	if aggregatorContext != "" {
+		data, err := os.ReadFile(aggregatorContext)
+		if err != nil {
+			err = fmt.Errorf("failed to read aggregator context: %w", err)
+			printer.PrintError(err)
+			return err
+		}
+		guidance = string(data)
+	}
+
+	var history []council.Message
+	if examplesFile != "" {
+		var err error
+		if history, err = readExamplesFile(examplesFile); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+	}
+
+	// Strip secrets from everything that is sent to the models
+	var scrub *redactor
+	if redact || len(redactPatterns) > 0 {
+		if scrub, err = newRedactor(redactPatterns); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		question = scrub.redact(question)
+		for i := range questions {
+			questions[i] = scrub.redact(questions[i])
+		}
+		promptPrefix = scrub.redact(promptPrefix)
+		promptSuffix = scrub.redact(promptSuffix)
+		guidance = scrub.redact(guidance)
+		scrub.redactHistory(history)
+		if scrub.count > 0 {
+			printer.PrintWarning("redacted %d sensitive value(s) before sending", scrub.count)
+		}
+	}
+
+	if diff && len(members) != 2 {
+		err := fmt.Errorf("--diff compares exactly two models, got %d", len(members))
+		printer.PrintError(err)
+		return err
+	}
+
+	if refineRounds < 0 || refineRounds > council.MaxRefineRounds {
+		err := fmt.Errorf("--refine-rounds must be between 0 and %d", council.MaxRefineRounds)
+		printer.PrintError(err)
+		return err
+	}
+
+	if proposerMode {
+		if cmd.Flags().Changed("mode") && mode != council.ModeProposer {
+			err := fmt.Errorf("--proposer-mode conflicts with --mode %s", mode)
+			printer.PrintError(err)
+			return err
+		}
+		mode = council.ModeProposer
+	}
+	if aggregator == council.NoAggregator {
+		if mode != council.ModeCouncil && mode != council.ModeGather {
+			err := fmt.Errorf("--aggregator %s conflicts with --mode %s", council.NoAggregator, mode)
+			printer.PrintError(err)
+			return err
+		}
+		mode = council.ModeGather
+	}
+	if mode == council.ModeGather && multiPrompt != "" {
+		err := fmt.Errorf("--mode %s cannot be combined with --multi-prompt", council.ModeGather)
+		printer.PrintError(err)
+		return err
+	}
+	if mode == council.ModeProposer && len(members) < 2 {
+		err := fmt.Errorf("proposer mode needs at least two models: a proposer and a critic")
+		printer.PrintError(err)
+		return err
+	}
+
+	if !council.IsMode(mode) {
+		err := fmt.Errorf("invalid --mode %q: expected one of %s", mode, strings.Join(council.Modes, ", "))
+		printer.PrintError(err)
+		return err
+	}
+
+	var outputSchema string
+	if outputSchemaFile != "" {
+		if mode != council.ModeCouncil || refineRounds > 0 {
+			err := fmt.Errorf("--output-schema votes on the members' first answers and cannot be combined with --mode %s or --refine-rounds", mode)
+			printer.PrintError(err)
+			return err
+		}
+		data, err := os.ReadFile(outputSchemaFile)
+		if err != nil {
+			err = fmt.Errorf("failed to read output schema: %w", err)
+			printer.PrintError(err)
+			return err
+		}
+		if err := council.ParseOutputSchema(string(data)); err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		outputSchema = string(data)
+	}
+
+	if tiebreaker != "" && mode != council.ModeCouncil && mode != council.ModeGather {
+		err := fmt.Errorf("--tiebreaker breaks ties in peer review and cannot be combined with --mode %s", mode)
+		printer.PrintError(err)
+		return err
+	}
+
+	if !council.IsChairmanPersona(chairmanPersona) {
+		err := fmt.Errorf("invalid --chairman-persona %q: expected one of %s", chairmanPersona, strings.Join(council.ChairmanPersonas, ", "))
+		printer.PrintError(err)
+		return err
+	}
+	if !council.IsFraming(framing) {
+		err := fmt.Errorf("invalid --framing %q: expected one of %s", framing, strings.Join(council.Framings, ", "))
+		printer.PrintError(err)
+		return err
+	}
+
+	if !council.IsAggregationStrategy(aggregationStrategy) {
+		err := fmt.Errorf("invalid --aggregation-strategy %q: expected one of %s", aggregationStrategy, strings.Join(council.AggregationStrategies, ", "))
+		printer.PrintError(err)
+		return err
+	}
+
+	if answerLength != "" && !council.IsAnswerLength(answerLength) {
+		err := fmt.Errorf("invalid --answer-length %q: expected one of %s", answerLength, strings.Join(council.AnswerLengths, ", "))
+		printer.PrintError(err)
+		return err
+	}
+	if maxAnswerWords < 0 {
+		err := fmt.Errorf("--max-answer-words must not be negative")
+		printer.PrintError(err)
+		return err
+	}
+	minLength, minWords, err := parseMinLength("--min-response-length", minResponseLength)
+	if err != nil {
+		printer.PrintError(err)
+		return err
+	}
+	minReviewLen, minReviewWords, err := parseMinLength("--min-review-length", minReviewLength)
+	if err != nil {
+		printer.PrintError(err)
+		return err
+	}
+	var minReviewerWords int
+	if qualityGatedReview {
+		if reviewerMinWords < 1 {
+			err := fmt.Errorf("--reviewer-min-words must be at least 1, got %d", reviewerMinWords)
+			printer.PrintError(err)
+			return err
+		}
+		minReviewerWords = reviewerMinWords
+	}
+	if minDistinct < 0 {
+		err := fmt.Errorf("--min-distinct must not be negative, got %d", minDistinct)
+		printer.PrintError(err)
+		return err
+	}
+
+	if maxOutputLines < 0 {
+		err := fmt.Errorf("--max-output-lines must not be negative")
+		printer.PrintError(err)
+		return err
+	}
+
+	if maxConcurrency < 0 {
+		err := fmt.Errorf("--max-concurrency must not be negative")
+		printer.PrintError(err)
+		return err
+	}
+
+	if !council.IsLabelScheme(reviewLabels) {
+		err := fmt.Errorf("invalid --review-labels %q: expected one of %s", reviewLabels, strings.Join(council.LabelSchemes, ", "))
+		printer.PrintError(err)
+		return err
+	}
+
+	weights, err := parseReviewerWeights(reviewerWeights)
+	if err != nil {
+		printer.PrintError(err)
+		return err
+	}
+
+	options, err := parseModelOptions(modelOptions)
+	if err != nil {
+		printer.PrintError(err)
+		return err
+	}
+
+	windows, err := parseContextWindows(contextWindows)
+	if err != nil {
+		printer.PrintError(err)
+		return err
+	}
+
+	var cache council.AggregationCache
+	if cacheAggregation && !noCache {
+		dir, err := defaultCacheDir("aggregations")
+		if err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		cache = &fileCache{dir: dir, ttl: cacheTTL}
+	}
+
+	var reused []copilot.Response
+	if reuseResponses {
+		if watchFile != "" || multiPrompt != "" {
+			err := fmt.Errorf("--reuse-responses works on a single question and cannot be combined with --watch or --multi-prompt")
+			printer.PrintError(err)
+			return err
+		}
+		dir, err := defaultCacheDir("stage1")
+		if err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		answering := members
+		if mode == council.ModeProposer {
+			answering = members[:1]
+		}
+		stageOne = &stageOneStore{dir: dir, question: promptPrefix + "\x00" + question + "\x00" + promptSuffix}
+		for _, member := range answering {
+			stageOne.members = append(stageOne.members, member.Name)
+		}
+
+		var created time.Time
+		reused, created, err = stageOne.Load()
+		switch {
+		case err != nil:
+			printer.PrintWarning("not reusing responses, asking the models again: %v", err)
+		case reused != nil:
+			printer.PrintVerbose("Reusing the answers stored %s", created.Format(time.RFC3339))
+			if age := time.Since(created); cacheTTL > 0 && age > cacheTTL {
+				printer.PrintWarning("reusing answers stored %s ago, older than --cache-ttl %s; run without --reuse-responses to refresh them", age.Round(time.Minute), cacheTTL)
+			}
+		}
+	}
+
+	// Offline replays whatever was recorded, however old
+	var responseCache council.ResponseCache
+	if offline && noCache {
+		err := fmt.Errorf("--offline answers from the response cache and cannot be combined with --no-cache")
+		printer.PrintError(err)
+		return err
+	}
+	if (cacheResponses || offline) && !noCache {
+		dir, err := defaultCacheDir("responses")
+		if err != nil {
+			printer.PrintError(err)
+			return err
+		}
+		ttl := cacheTTL
+		if offline {
+			ttl = 0
+		}
+		responseCache = &fileCache{dir: dir, ttl: ttl}
+	}
+
+	var latencyHints map[string]time.Duration
+	if weightByLatency {
+		latencyHints = latencies
+	}
+
+	var tracer council.PromptTraceFunc
+	if tracePrompts {
+		tracer = printer.PrintPromptTrace
+	}
+
 	// Create council
-	c, err := council.NewCouncil(council.Config{
+	c, err := newCouncil(council.Config{
 		Models:     models,
+		Members:    members,
 		Aggregator: aggregator,
 		Timeout:    time.Duration(timeout) * time.Second,
 		Verbose:    verbose,
 		OriginalQ:  question,
+
+		PromptPrefix: promptPrefix,
+		PromptSuffix: promptSuffix,
+
+		MaxReviewPairs:  maxReviewPairs,
+		ReviewerWeights: weights,
+		ReviewQuorum:    reviewQuorum,
+		MaxReviewers:    maxReviewers,
+		ReviewerSeed:    reviewerSeed,
+		TracePrompt:     tracer,
+		SplitReasoning:  splitReasoning,
+		RetryEmpty:      retryEmpty,
+
+		RequireAllModels: requireAllModels,
+		History:          history,
+		ReviewLabels:     reviewLabels,
+		SmartAggregate:   smartAggregate,
+		RefineRounds:     refineRounds,
+		ModelOptions:     options,
+		CollectDissents:  dissent,
+		VerifyQuotes:     verifyQuotes,
+		Warmup:           warmup,
+		QueryOnly:        diff,
+		Mode:             mode,
+		ChairmanPersona:  chairmanPersona,
+		Framing:          framing,
+		AnswerLength:     answerLength,
+		MaxAnswerWords:   maxAnswerWords,
+		NoReviewFrom:     noReviewFrom,
+		ReviewerMinWords: minReviewerWords,
+		AggregationCache: cache,
+		ResponseCache:    responseCache,
+		ReuseResponses:   reused,
+		Offline:          offline,
+		IncludePartial:   includePartial,
+		Stream:           verbose || includePartial || eventsFile != "",
+		LatencyHints:     latencyHints,
+		MaxConcurrency:   maxConcurrency,
+		ExecModels:       execModels,
+		DetectRefusals:   detectRefusals,
+		RevealAuthors:    !reviewAnonymize,
+		StrictReviews:    strictJSON,
+
+		AggregatorContext: guidance,
+		ContextWindows:    windows,
+
+		AggregationStrategy: aggregationStrategy,
+		CollectSources:      collectSources,
+
+		MinResponseLength: minLength,
+		MinResponseWords:  minWords,
+		MinDistinct:       minDistinct,
+		DistinctPool:      distinctPool,
+
+		CompareStrategies: compareStrategies,
+
+		AggregateSingleSuccess: !singleSuccessFallback,
+		OutputSchema:           outputSchema,
+		AnalyzeResponses:       analyzeResponses,
+		Tiebreaker:             tiebreaker,
+		MinReviewLength:        minReviewLen,
+		MinReviewWords:         minReviewWords,
 	})
 	if err != nil {
 		printer.PrintError(err)
 		return err
 	}
-	defer c.Close()
+	defer func() {
+		// Close destroys the warm sessions, so count after it
+		err := c.Close()
+		if destroyed, failed, ok := c.SessionsDestroyed(); ok {
+			if failed == 0 {
+				printer.PrintVerbose("%d sessions destroyed cleanly", destroyed)
+			} else {
+				printer.PrintVerbose("%d sessions destroyed cleanly, %d failed", destroyed, failed)
+			}
+		}
+		if err != nil {
+			printer.PrintWarning("cleanup failed, sessions may have leaked: %v", err)
+		}
+	}()
+
+	if eventsFile != "" {
+		file, err := os.Create(eventsFile)
+		if err != nil {
+			err = fmt.Errorf("failed to create events file: %w", err)
+			printer.PrintError(err)
+			return err
+		}
+		eventLog = output.NewEventWriter(file)
+		defer func() {
+			if err := eventLog.Err(); err != nil {
+				printer.PrintWarning("could not write every event to %s: %v", eventsFile, err)
+			}
+			if err := file.Close(); err != nil {
+				printer.PrintWarning("could not write every event to %s: %v", eventsFile, err)
+			}
+			eventLog = nil
+		}()
+	}
 
-	// Execute council pattern
-	ctx := context.Background()
+	if multiPrompt != "" {
+		return executeMulti(context.Background(), c, printer, questions)
+	}
+	if watchFile != "" {
+		return watchPromptFile(c, printer, watchFile, question, scrub)
+	}
+	return execute(context.Background(), c, printer, question)
+}
+
+// execute runs the council on one question and prints the results
+func execute(ctx context.Context, c *council.Council, printer *output.Printer, question string) error {
 	startTime := time.Now()
 
-	// Print querying start
-	printer.PrintQueryingStart()
+	// The printer renders phase banners and spinners from the event stream
+	var result council.Result
+	withSkipKey(c, printer, func() {
+		result = c.ExecuteWithEvents(ctx, question, handleEvents(printer))
+	})
+	printer.EndCIGroup()
+	printer.StopHeartbeat()
 
-	// Start spinners for each model
-	for _, model := range models {
-		printer.StartModelSpinner(model)
+	printer.PrintBlankLine() // Space after spinners
+	printPanics(printer, result)
+	if stageOne != nil && !result.ResponsesReused {
+		storeStageOne(printer, result)
 	}
-
-	// Progress callback to update spinners
-	progressCallback := func(model string, duration time.Duration, err error) {
-		printer.StopModelSpinner(model, duration, err)
+	if csvAppend != "" {
+		appendTimings(printer, []string{question}, []council.Result{result}, time.Since(startTime))
+	}
+	if weightByLatency || autoOrder {
+		recordLatencies(printer, result.ModelResponses)
 	}
 
-	// Phase callback to print phase transitions
-	phaseCallback := func(phase string, modelCount int) {
-		if phase == "review" {
-			printer.PrintReviewStart(modelCount)
+	// A/B mode: compare the two answers instead of synthesizing them
+	if diff {
+		if result.Error != nil {
+			printer.PrintError(result.Error)
+			return result.Error
+		}
+		printer.PrintDiff(result.ModelResponses)
+		printer.PrintSummary(result, time.Since(startTime))
+		if jsonFooter {
+			printer.PrintJSONFooter(result, time.Since(startTime))
 		}
+		return nil
 	}
 
-	result := c.Execute(ctx, question, progressCallback, phaseCallback)
-
-	fmt.Println() // Space after spinners
-
 	// Print individual model responses (only in verbose mode)
 	if verbose {
-		// Show initial prompt
-		printer.PrintPrompt("All Council Models", result.InitialPrompt)
-		
+		// Successful answers were printed as they arrived; show the failures
+		// and the answers that broke off mid-stream now
 		for _, resp := range result.ModelResponses {
-			printer.PrintModelResponse(resp)
+			if resp.Error != nil || resp.Partial {
+				printer.PrintModelResponse(resp)
+			}
 		}
 		
 		// Print peer review prompts and results in verbose mode
 		if len(result.Reviews) > 0 {
+			if maxReviewers > 0 {
+				printer.PrintVerbose("Reviewers: %s", strings.Join(result.Reviewers, ", "))
+			}
 			for _, review := range result.Reviews {
 				if prompt, ok := result.ReviewPrompts[review.ReviewerModel]; ok {
 					printer.PrintPrompt(review.ReviewerModel+" (reviewing others)", prompt)
@@ -123,10 +1048,50 @@ func run(cmd *cobra.Command, args []string) error {
 			}
 			printer.PrintPeerReviews(result.Reviews)
 		}
+		printer.PrintGroupSummaries(result.GroupSummaries)
 		
 		// Show aggregation prompt
 		if result.AggregationPrompt != "" {
-			printer.PrintPrompt(aggregator+" (Chairman)", result.AggregationPrompt)
+			role := "Chairman"
+			if framing == council.FramingNeutral {
+				role = "Synthesizer"
+			}
+			printer.PrintPrompt(result.AggregatorModel+" ("+role+")", result.AggregationPrompt)
+		}
+	}
+
+	if len(result.Reductions) > 0 {
+		printer.PrintWarning("the aggregation prompt was too long for %s; dropped: %s", result.AggregatorModel, strings.Join(result.Reductions, ", "))
+	}
+	if result.ContextWindow > 0 && result.PromptTokens > result.ContextWindow {
+		printer.PrintWarning("the aggregation prompt is about %d tokens, over the %d-token context window of %s", result.PromptTokens, result.ContextWindow, result.AggregatorModel)
+	}
+	if result.CacheError != nil {
+		printer.PrintWarning("could not cache the synthesis: %v", result.CacheError)
+	}
+	if result.ReplacedAggregator != "" {
+		printer.PrintWarning("aggregator %s failed as a council member; using %s instead", result.ReplacedAggregator, result.AggregatorModel)
+	}
+	for _, review := range result.Reviews {
+		if len(review.Malformed) > 0 {
+			printer.PrintWarning("ignored part of %s's ranking, which did not follow the format: %s", review.ReviewerModel, strings.Join(review.Malformed, ", "))
+		}
+	}
+	for _, violation := range result.NonConforming {
+		printer.PrintWarning("%s did not answer in the output schema (%s); left out of the vote", violation.Member, violation.Reason)
+	}
+	if len(result.AddedMembers) > 0 {
+		printer.PrintVerbose("Added for --min-distinct: %s", strings.Join(result.AddedMembers, ", "))
+	}
+	if minDistinct > 0 && result.DistinctViewpoints < minDistinct && result.Error == nil {
+		printer.PrintWarning("only %d distinct viewpoints of the %d required; no more models to ask", result.DistinctViewpoints, minDistinct)
+	}
+
+	// Show how refinement changed the answer
+	printer.PrintRoundDrafts(result.Rounds)
+	for _, round := range result.Rounds {
+		if round.Error != nil {
+			printer.PrintWarning("refinement stopped early, keeping the previous draft: %v", round.Error)
 		}
 	}
 
@@ -144,24 +1109,138 @@ func run(cmd *cobra.Command, args []string) error {
 			printer.PrintReviewPhaseComplete(len(result.Reviews), result.ReviewDuration)
 		}
 
-		printer.PrintAggregationStart(aggregator, successCount)
-		printer.StopAggregationSpinner(result.AggregationDuration)
-		printer.PrintFinalResult(result.AggregatedResponse)
+		switch {
+		case mode == council.ModeGather:
+			// The answers and their ranking stand in for a final answer
+			printer.PrintGathered(result)
+		case result.AggregationSkipped != "":
+			printer.PrintAggregationSkipped(result.AggregationSkipped, result.AggregatorModel)
+			printer.PrintFinalResult(result.AggregatedResponse)
+		case result.AggregationCached:
+			printer.PrintAggregationSkipped(output.SkipCached, result.AggregatorModel)
+			printer.PrintFinalResult(result.AggregatedResponse)
+		case len(result.FieldVotes) > 0:
+			// The vote on each field is the answer; there was no chairman
+			printer.PrintBlankLine()
+			printer.PrintFinalResult(result.AggregatedResponse)
+		default:
+			printer.PrintAggregationStart(result.AggregatorModel, successCount)
+			printer.StopAggregationSpinner(result.AggregationDuration)
+			printer.PrintAggregatorReasoning(result.AggregatorReasoning)
+			printer.PrintFinalResult(result.AggregatedResponse)
+		}
+		printer.PrintStrategies(result.Strategies)
+		printer.PrintDissents(result.Dissents)
+		printer.PrintClaimChecks(result.ClaimChecks)
+		if result.VerifyError != nil {
+			printer.PrintWarning("could not verify the claims of the answer: %v", result.VerifyError)
+		}
+		if warnOnEcho && result.EchoedModel != "" {
+			printer.PrintEchoWarning(result.EchoedModel, result.EchoSimilarity)
+		}
 	} else {
 		printer.PrintError(result.Error)
+		if errors.Is(result.Error, council.ErrMissingModels) {
+			printer.PrintSummary(result, time.Since(startTime))
+		}
+		if jsonFooter {
+			printer.PrintJSONFooter(result, time.Since(startTime))
+		}
 		return result.Error
 	}
 
 	// Print summary
 	duration := time.Since(startTime)
 	printer.PrintSummary(result, duration)
+	if jsonFooter {
+		printer.PrintJSONFooter(result, duration)
+	}
+
+	// Save the extra renderings next to the terminal output; pipelines that
+	// asked for --strict-json need them, so failing to write them fails the run
+	reportErrs := writeReports(alsoJSON, alsoMarkdown, strictJSON, question, result, duration)
+	for _, err := range reportErrs {
+		if strictJSON {
+			printer.PrintError(err)
+		} else {
+			printer.PrintWarning("%v", err)
+		}
+	}
+	if strictJSON && len(reportErrs) > 0 {
+		return reportErrs[0]
+	}
+
+	// Archive each response as its own file
+	if responsesDir != "" {
+		for _, err := range writeResponsesDir(responsesDir, result) {
+			printer.PrintWarning("%v", err)
+		}
+	}
+
+	// Hand the final answer to an external command
+	if pipeTo != "" {
+		if err := pipeToCommand(ctx, pipeTo, result.AggregatedResponse); err != nil {
+			if pipeStrict {
+				printer.PrintError(err)
+				return err
+			}
+			printer.PrintWarning("%v", err)
+		}
+	}
 
 	return nil
 }
 
+// printPanics prints, in verbose mode, the stack of every request of the
+// run that panicked; the panic is already that request's error
+func printPanics(printer *output.Printer, result council.Result) {
+	report := func(who string, err error) {
+		var panicErr *copilot.PanicError
+		if errors.As(err, &panicErr) {
+			printer.PrintVerbose("%s panicked: %v\n%s", who, panicErr.Value, panicErr.Stack)
+		}
+	}
+	for _, resp := range result.ModelResponses {
+		report(resp.Label(), resp.Error)
+	}
+	for _, critique := range result.Critiques {
+		report(critique.Label()+" (critique)", critique.Error)
+	}
+	for _, review := range result.Reviews {
+		report(review.ReviewerModel+" (review)", review.Error)
+	}
+}
+
+// newCouncil creates a council backed by the real Copilot client, or by the
+// mock client when --mock or --mock-fixture is set
+func newCouncil(config council.Config) (*council.Council, error) {
+	if !mock && mockFixture == "" {
+		return council.NewCouncil(config)
+	}
+
+	var fixture *copilot.MockFixture
+	if mockFixture != "" {
+		var err error
+		if fixture, err = copilot.LoadMockFixture(mockFixture); err != nil {
+			return nil, err
+		}
+	}
+	return council.NewCouncilWithClient(config, copilot.NewMockClient(fixture, true)), nil
+}
+
 // Execute runs the root command
 func Execute(ver string) {
 	rootCmd.Version = ver
+
+	// Default flags from the rc file go in front of the explicit ones
+	rcFile = findRCFile()
+	args, err := withRCArgs(rootCmd, rcFile, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	rootCmd.SetArgs(args)
+
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+)
+
+// appendTimings appends the timing row of each run to the --csv-append
+// file. The questions of a --multi-prompt batch run one after another and
+// are timed as a whole, so their rows leave the total empty.
+func appendTimings(printer *output.Printer, questions []string, results []council.Result, totalDuration time.Duration) {
+	now := time.Now()
+	rows := make([][]string, len(results))
+	for i, result := range results {
+		rows[i] = output.NewCSVRow(now, questions[i], result, totalDuration)
+	}
+	if err := output.AppendCSV(csvAppend, rows...); err != nil {
+		printer.PrintWarning("could not append the timings to %s: %v", csvAppend, err)
+	}
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+	"github.com/openjny/council/internal/output"
+)
+
+const (
+	// watchInterval is how often the prompt file is checked for changes
+	watchInterval = 250 * time.Millisecond
+	// watchDebounce is how long the file must stay unchanged before a re-run,
+	// so that editors writing a file in several steps trigger one run
+	watchDebounce = 300 * time.Millisecond
+)
+
+// readPromptFile reads a question from a file
+func readPromptFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read prompt file: %w", err)
+	}
+	question := strings.TrimSpace(string(data))
+	if question == "" {
+		return "", fmt.Errorf("prompt file %s is empty", path)
+	}
+	return question, nil
+}
+
+// fileStamp identifies one version of a file
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// statFile returns the current stamp of a file; a missing file has the zero stamp
+func statFile(path string) fileStamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}
+}
+
+// waitForChange polls the file until its stamp differs from last and then stays
+// the same for debounce. It returns the new stamp, or the context's error.
+// A file that disappears, as during an editor's atomic save, is waited out.
+func waitForChange(ctx context.Context, path string, last fileStamp, interval, debounce time.Duration) (fileStamp, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	pending := last
+	var settledAt time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+
+		stamp := statFile(path)
+		switch {
+		case stamp == (fileStamp{}):
+			// Missing for now; keep waiting for it to come back
+		case stamp != pending:
+			pending = stamp
+			settledAt = time.Now().Add(debounce)
+		case stamp != last && !time.Now().Before(settledAt):
+			return stamp, nil
+		}
+	}
+}
+
+// watchPromptFile runs the council on the question read from the prompt file, then re-runs it with the
+// same client each time the file is saved, until interrupted
+func watchPromptFile(c *council.Council, printer *output.Printer, path, question string, scrub *redactor) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stamp := statFile(path)
+	var err error
+	for {
+		// Failures are already reported; keep watching for a fixed prompt
+		_ = execute(ctx, c, printer, question)
+		if ctx.Err() != nil {
+			return nil // Interrupted mid-run
+		}
+
+		printer.PrintWatching(path)
+		for {
+			if stamp, err = waitForChange(ctx, path, stamp, watchInterval, watchDebounce); err != nil {
+				return nil // Interrupted
+			}
+			if question, err = readPromptFile(path); err == nil {
+				break
+			}
+			printer.PrintWarning("%v", err)
+		}
+
+		printer.ClearScreen()
+		printer.PrintBanner()
+		printer.PrintQuestion(question)
+		if scrub != nil {
+			before := scrub.count
+			question = scrub.redact(question)
+			if n := scrub.count - before; n > 0 {
+				printer.PrintWarning("redacted %d sensitive value(s) before sending", n)
+			}
+		}
+	}
+}
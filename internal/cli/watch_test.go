@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReadPromptFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "prompt.txt")
+	if err := os.WriteFile(path, []byte("\n  What is a monad?\n\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readPromptFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "What is a monad?" {
+		t.Errorf("question = %q", got)
+	}
+
+	empty := filepath.Join(dir, "empty.txt")
+	if err := os.WriteFile(empty, []byte(" \n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readPromptFile(empty); err == nil {
+		t.Error("expected an error for an empty prompt file")
+	}
+	if _, err := readPromptFile(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Error("expected an error for a missing prompt file")
+	}
+}
+
+func TestWaitForChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prompt.txt")
+	if err := os.WriteFile(path, []byte("v1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	last := statFile(path)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = os.WriteFile(path, []byte("version 2"), 0o644)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	stamp, err := waitForChange(ctx, path, last, 5*time.Millisecond, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stamp == last || stamp.size != int64(len("version 2")) {
+		t.Errorf("stamp = %+v, want the new version", stamp)
+	}
+}
+
+func TestWaitForChangeStopsOnCancel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prompt.txt")
+	if err := os.WriteFile(path, []byte("unchanged"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := waitForChange(ctx, path, statFile(path), 5*time.Millisecond, 5*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
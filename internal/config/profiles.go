@@ -0,0 +1,152 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is a named council preset. Zero-valued fields are left to flags and defaults.
+type Profile struct {
+	Description     string             `yaml:"description" json:"description,omitempty"`
+	Models          []string           `yaml:"models" json:"models,omitempty"`
+	Aggregator      string             `yaml:"aggregator" json:"aggregator,omitempty"`
+	Mode            string             `yaml:"mode" json:"mode,omitempty"`
+	Timeout         int                `yaml:"timeout" json:"timeout,omitempty"`
+	PromptPrefix    string             `yaml:"prompt_prefix" json:"prompt_prefix,omitempty"`
+	PromptSuffix    string             `yaml:"prompt_suffix" json:"prompt_suffix,omitempty"`
+	MaxReviewPairs  int                `yaml:"max_review_pairs" json:"max_review_pairs,omitempty"`
+	ReviewQuorum    int                `yaml:"review_quorum" json:"review_quorum,omitempty"`
+	ReviewerWeights map[string]float64 `yaml:"reviewer_weights" json:"reviewer_weights,omitempty"`
+}
+
+// ProfilesFile is the on-disk layout of a profiles file
+type ProfilesFile struct {
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// DefaultProfilesPath returns the profiles file used when none is given:
+// $XDG_CONFIG_HOME/copilot-council/profiles.yaml (or the OS equivalent)
+func DefaultProfilesPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "copilot-council", "profiles.yaml")
+}
+
+// LoadProfiles reads a YAML or JSON profiles file
+func LoadProfiles(path string) (map[string]Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles file: %w", err)
+	}
+
+	var file ProfilesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles file %s: %w", path, err)
+	}
+	return file.Profiles, nil
+}
+
+// ParseProfileJSON reads a single council configuration given inline as a
+// JSON object, with the fields of a profile. Errors name the offending field.
+func ParseProfileJSON(data string) (Profile, error) {
+	if err := checkProfileKeys(data); err != nil {
+		return Profile{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.DisallowUnknownFields()
+
+	var p Profile
+	err := decoder.Decode(&p)
+	if err == nil && decoder.More() {
+		err = errors.New("unexpected data after the JSON object")
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case err == nil:
+		return p, nil
+	case errors.As(err, &typeErr):
+		return Profile{}, fmt.Errorf("invalid config JSON: field %q: expected %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value)
+	case errors.As(err, &syntaxErr):
+		return Profile{}, fmt.Errorf("invalid config JSON at offset %d: %w", syntaxErr.Offset, err)
+	default:
+		return Profile{}, fmt.Errorf("invalid config JSON: %s", strings.TrimPrefix(err.Error(), "json: "))
+	}
+}
+
+// checkProfileKeys rejects top-level keys a profile does not have, listing the ones it does
+func checkProfileKeys(data string) error {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal([]byte(data), &fields) != nil {
+		return nil // the decoder reports it with more context
+	}
+	if _, ok := fields["profiles"]; ok {
+		return errors.New(`invalid config JSON: "profiles" belongs in a profiles file; give the fields of one profile instead`)
+	}
+
+	known := profileKeys()
+	var unknown []string
+	for key := range fields {
+		if !slices.Contains(known, key) {
+			unknown = append(unknown, fmt.Sprintf("%q", key))
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	noun := "field"
+	if len(unknown) > 1 {
+		noun = "fields"
+	}
+	return fmt.Errorf("invalid config JSON: unknown %s %s (known fields: %s)", noun, strings.Join(unknown, ", "), strings.Join(known, ", "))
+}
+
+// profileKeys returns the JSON keys of a Profile in sorted order
+func profileKeys() []string {
+	t := reflect.TypeOf(Profile{})
+	keys := make([]string, 0, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SelectProfile returns the named profile, or an error listing the available ones
+func SelectProfile(profiles map[string]Profile, name string) (Profile, error) {
+	if p, ok := profiles[name]; ok {
+		return p, nil
+	}
+
+	names := ProfileNames(profiles)
+	if len(names) == 0 {
+		return Profile{}, fmt.Errorf("profile %q not found: no profiles are defined", name)
+	}
+	return Profile{}, fmt.Errorf("profile %q not found (available: %s)", name, strings.Join(names, ", "))
+}
+
+// ProfileNames returns the profile names in sorted order
+func ProfileNames(profiles map[string]Profile) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadProfilesYAML(t *testing.T) {
+	path := writeFile(t, "profiles.yaml", `
+profiles:
+  coding:
+    description: Code review council
+    models: [claude-sonnet-4.5, gpt-5.2-codex]
+    aggregator: gpt-5.2
+    timeout: 120
+    reviewer_weights:
+      gpt-5.2-codex: 1.5
+  writing:
+    models:
+      - claude-opus-4.5
+`)
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	coding := profiles["coding"]
+	if len(coding.Models) != 2 || coding.Aggregator != "gpt-5.2" || coding.Timeout != 120 {
+		t.Errorf("unexpected coding profile: %+v", coding)
+	}
+	if coding.ReviewerWeights["gpt-5.2-codex"] != 1.5 {
+		t.Errorf("expected reviewer weight 1.5, got %v", coding.ReviewerWeights)
+	}
+	if got := ProfileNames(profiles); strings.Join(got, ",") != "coding,writing" {
+		t.Errorf("unexpected profile names: %v", got)
+	}
+}
+
+func TestLoadProfilesJSON(t *testing.T) {
+	path := writeFile(t, "profiles.json", `{"profiles": {"quick": {"models": ["gpt-5-mini"], "review_quorum": 2}}}`)
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quick := profiles["quick"]; len(quick.Models) != 1 || quick.ReviewQuorum != 2 {
+		t.Errorf("unexpected quick profile: %+v", quick)
+	}
+}
+
+func TestSelectProfile(t *testing.T) {
+	profiles := map[string]Profile{"coding": {}, "writing": {}}
+
+	if _, err := SelectProfile(profiles, "coding"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err := SelectProfile(profiles, "legal")
+	if err == nil || !strings.Contains(err.Error(), "coding, writing") {
+		t.Errorf("expected error listing available profiles, got %v", err)
+	}
+}
+
+func TestParseProfileJSONRoundTrip(t *testing.T) {
+	want := Profile{
+		Models:          []string{"claude-sonnet-4.5", "gpt-5.2"},
+		Aggregator:      "gpt-5.2",
+		Mode:            "roundtable",
+		Timeout:         90,
+		PromptPrefix:    "You are reviewing Go code.",
+		PromptSuffix:    "Answer briefly.",
+		MaxReviewPairs:  4,
+		ReviewQuorum:    2,
+		ReviewerWeights: map[string]float64{"gpt-5.2": 1.5},
+	}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ParseProfileJSON(string(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseProfileJSON(%s) = %+v, want %+v", data, got, want)
+	}
+}
+
+func TestParseProfileJSONErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`{"timeout": "slow"}`, `field "timeout": expected int, got string`},
+		{`{"reviewer_weights": {"gpt-5.2": "high"}}`, `field "reviewer_weights.gpt-5.2": expected float64, got string`},
+		{`{"model": "gpt-5.2"}`, `unknown field "model" (known fields: aggregator, description, max_review_pairs, mode, models,`},
+		{`{"profiles": {"fast": {"models": ["gpt-5.2"]}}}`, `"profiles" belongs in a profiles file`},
+		{`{"models": ["gpt-5.2"]`, "unexpected EOF"},
+		{`{"models": ["gpt-5.2",]}`, "at offset 23"},
+		{`{} {}`, "unexpected data after the JSON object"},
+	}
+	for _, tt := range tests {
+		_, err := ParseProfileJSON(tt.data)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseProfileJSON(%s) error = %v, want it to mention %q", tt.data, err, tt.want)
+		}
+	}
+}
+
+func TestParseProfileJSONMultiSection(t *testing.T) {
+	data := `{
+		"description": "Go reviews",
+		"models": ["claude-sonnet-4.5", "gpt-5.2", "gemini-3-pro"],
+		"aggregator": "gpt-5.2",
+		"mode": "roundtable",
+		"timeout": 120,
+		"prompt_prefix": "You are reviewing Go code.",
+		"prompt_suffix": "Answer briefly.",
+		"max_review_pairs": 3,
+		"review_quorum": 2,
+		"reviewer_weights": {"gpt-5.2": 1.5, "gemini-3-pro": 0.5}
+	}`
+	want := Profile{
+		Description:     "Go reviews",
+		Models:          []string{"claude-sonnet-4.5", "gpt-5.2", "gemini-3-pro"},
+		Aggregator:      "gpt-5.2",
+		Mode:            "roundtable",
+		Timeout:         120,
+		PromptPrefix:    "You are reviewing Go code.",
+		PromptSuffix:    "Answer briefly.",
+		MaxReviewPairs:  3,
+		ReviewQuorum:    2,
+		ReviewerWeights: map[string]float64{"gpt-5.2": 1.5, "gemini-3-pro": 0.5},
+	}
+	got, err := ParseProfileJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseProfileJSON() = %+v, want %+v", got, want)
+	}
+
+	// Stray keys among valid sections are all named
+	_, err = ParseProfileJSON(`{"models": ["gpt-5.2"], "mode": "gather", "temperature": 0.2, "weights": {}}`)
+	if err == nil || !strings.Contains(err.Error(), `unknown fields "temperature", "weights" (known fields:`) {
+		t.Errorf("ParseProfileJSON() error = %v, want it to name both unknown fields", err)
+	}
+}
@@ -2,6 +2,7 @@ package copilot
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -13,6 +14,16 @@ import (
 type Client struct {
 	client *copilot.Client
 	mu     sync.Mutex
+
+	// sessionMu guards the session cleanup bookkeeping and the warm pool
+	sessionMu   sync.Mutex
+	destroyed   int
+	destroyErrs []error
+	warm        map[string]*copilot.Session // Member name -> pre-created session, used once
+
+	throttle      *throttle // Lowers concurrency when the backend rate limits requests
+	maxConcurrent int       // Members of AskMultipleModels asked at once; 0 asks them all
+	streaming     bool      // Query sessions stream, see SetStreaming
 }
 
 // NewClient creates a new Copilot client wrapper
@@ -26,22 +37,97 @@ func NewClient() (*Client, error) {
 	}
 
 	return &Client{
-		client: client,
+		client:   client,
+		throttle: newThrottle(),
 	}, nil
 }
 
-// Close stops the Copilot client
+// Close stops the Copilot client. Sessions that failed to be destroyed
+// earlier are reported here, alongside any error from stopping the client.
 func (c *Client) Close() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	// Destroy warm sessions nobody asked for
+	c.sessionMu.Lock()
+	warm := c.warm
+	c.warm = nil
+	c.sessionMu.Unlock()
+	for name, session := range warm {
+		c.destroySession(session, name)
+	}
+
+	var errs []error
+	c.sessionMu.Lock()
+	if len(c.destroyErrs) > 0 {
+		errs = append(errs, fmt.Errorf("failed to destroy %d session(s): %w", len(c.destroyErrs), errors.Join(c.destroyErrs...)))
+	}
+	c.sessionMu.Unlock()
+
 	if c.client != nil {
-		errs := c.client.Stop()
-		if len(errs) > 0 {
-			return fmt.Errorf("errors stopping client: %v", errs)
+		if stopErrs := c.client.Stop(); len(stopErrs) > 0 {
+			errs = append(errs, fmt.Errorf("errors stopping client: %v", stopErrs))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
+}
+
+// OnThrottle sets the function told when rate limiting makes the client
+// lower its concurrency and back off
+func (c *Client) OnThrottle(handler ThrottleHandler) {
+	c.throttle.setHandler(handler)
+}
+
+// SetMaxConcurrency caps how many members AskMultipleModels asks at once.
+// Members get a slot in the order given, so the first ones are asked first.
+// 0 removes the cap.
+func (c *Client) SetMaxConcurrency(n int) {
+	c.maxConcurrent = n
+}
+
+// SetStreaming makes AskMultipleModels ask over streaming sessions, so that
+// TimeToFirstToken is measured and an answer cut off by a timeout keeps the
+// content that arrived. Off by default.
+func (c *Client) SetStreaming(on bool) {
+	c.streaming = on
+}
+
+// SessionsDestroyed returns how many sessions have been destroyed cleanly,
+// and how many failed to be
+func (c *Client) SessionsDestroyed() (destroyed, failed int) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	return c.destroyed, len(c.destroyErrs)
+}
+
+// destroySession destroys a session, recording the outcome for Close
+func (c *Client) destroySession(session *copilot.Session, model string) {
+	err := session.Destroy()
+
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	if err != nil {
+		c.destroyErrs = append(c.destroyErrs, fmt.Errorf("%s: %w", model, err))
+		return
+	}
+	c.destroyed++
+}
+
+// ListModels returns the IDs of the models available to the signed-in user
+func (c *Client) ListModels() ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	infos, err := c.client.ListModels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", classifyError(err))
+	}
+
+	ids := make([]string, 0, len(infos))
+	for _, info := range infos {
+		ids = append(ids, info.ID)
+	}
+	return ids, nil
 }
 
 // ModelSession represents a session with a specific model
@@ -50,160 +136,317 @@ type ModelSession struct {
 	Session *copilot.Session
 }
 
-// CreateSession creates a session for a specific model
-func (c *Client) CreateSession(ctx context.Context, model string, streaming bool) (*copilot.Session, error) {
+// CreateSession creates a session for a specific model.
+// A non-empty systemPrompt is appended to the default system message.
+func (c *Client) CreateSession(ctx context.Context, model string, systemPrompt string, streaming bool) (*copilot.Session, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	session, err := c.client.CreateSession(&copilot.SessionConfig{
+	config := &copilot.SessionConfig{
 		Model:     model,
 		Streaming: streaming,
-	})
+	}
+	if systemPrompt != "" {
+		config.SystemMessage = &copilot.SystemMessageConfig{
+			Mode:    "append",
+			Content: systemPrompt,
+		}
+	}
+
+	session, err := c.client.CreateSession(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create session for model %s: %w", model, err)
+		return nil, fmt.Errorf("%w for model %s: %w", ErrSessionCreate, model, withDetail(err, ""))
 	}
 
 	return session, nil
 }
 
+// Warmup creates a session for each member in parallel ahead of time, so the
+// next AskMultipleModels call measures inference only. Each warm session serves
+// one request; members whose warmup failed create their session when asked.
+func (c *Client) Warmup(ctx context.Context, members []Member, timeout time.Duration) error {
+	warmCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(members))
+	for i, member := range members {
+		wg.Add(1)
+		go func(idx int, mbr Member) {
+			defer wg.Done()
+
+			session, err := c.CreateSession(warmCtx, mbr.Model, mbr.SystemPrompt, c.streaming)
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+
+			c.sessionMu.Lock()
+			if c.warm == nil {
+				c.warm = make(map[string]*copilot.Session)
+			}
+			old, replaced := c.warm[mbr.Name]
+			c.warm[mbr.Name] = session
+			c.sessionMu.Unlock()
+
+			if replaced {
+				c.destroySession(old, mbr.Model)
+			}
+		}(i, member)
+	}
+
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// takeSession hands out the member's warm session, creating one if there is none
+func (c *Client) takeSession(ctx context.Context, member Member) (*copilot.Session, error) {
+	c.sessionMu.Lock()
+	session, ok := c.warm[member.Name]
+	delete(c.warm, member.Name)
+	c.sessionMu.Unlock()
+
+	if ok {
+		return session, nil
+	}
+	return c.CreateSession(ctx, member.Model, member.SystemPrompt, c.streaming)
+}
+
+// ErrEmptyResponse reports a model that finished without producing any content
+var ErrEmptyResponse = errors.New("empty response from model")
+
+// Member is one seat on the council: a model, optionally wearing a persona
+type Member struct {
+	Name         string // Shown in prompts and output; the model name for a plain model
+	Model        string
+	SystemPrompt string
+	Options      SendOptions
+}
+
+// ModelMember returns the member for a model used without a persona
+func ModelMember(model string) Member {
+	return Member{Name: model, Model: model}
+}
+
+// ModelMembers returns one plain member per model
+func ModelMembers(models []string) []Member {
+	members := make([]Member, len(models))
+	for i, model := range models {
+		members[i] = ModelMember(model)
+	}
+	return members
+}
+
 // Response represents a model's response
 type Response struct {
+	Member   string // Council member that answered; empty means Model
 	Model    string
 	Content  string
 	Error    error
 	Duration time.Duration
+	// TimeToFirstToken is the time from sending the prompt to the first content.
+	// It equals about the whole generation time when the session does not stream.
+	TimeToFirstToken time.Duration
+	// ErrorDetail holds the status code and request ID behind Error, when the SDK reported them
+	ErrorDetail *ErrorDetail
+	// Partial reports a streamed answer that broke off: Content holds what
+	// arrived before the failure
+	Partial bool
+	// Refused reports an answer that declines to help; set by the council
+	// when refusal detection is on
+	Refused bool
+	// Retries counts how many times the member was asked again after an
+	// unusable answer
+	Retries int
+	// Citations lists the sources (URLs, DOIs, arXiv IDs) the answer cites;
+	// filled in by the council when source collection is on
+	Citations []string
+	// WordCount, DetectedLanguage (an ISO 639-1 code, empty when unknown)
+	// and Sentiment (positive, negative or neutral; English answers only)
+	// describe the answer; filled in by the council when response analysis is on
+	WordCount        int
+	DetectedLanguage string
+	Sentiment        string
+}
+
+// Label returns the name the response is shown and ranked under
+func (r Response) Label() string {
+	if r.Member != "" {
+		return r.Member
+	}
+	return r.Model
 }
 
 // ProgressCallback is called when a model completes
 type ProgressCallback func(model string, duration time.Duration, err error)
 
-// AskMultipleModels asks the same question to multiple models in parallel
-func (c *Client) AskMultipleModels(ctx context.Context, models []string, question string, timeout time.Duration, progress ProgressCallback) []Response {
-	var wg sync.WaitGroup
-	responses := make([]Response, len(models))
+// ResponseCallback is called with each member's response as soon as it completes
+type ResponseCallback func(resp Response)
 
-	for i, model := range models {
-		wg.Add(1)
-		go func(idx int, mdl string) {
-			defer wg.Done()
+// ProgressResponses adapts a ProgressCallback to a ResponseCallback
+func ProgressResponses(progress ProgressCallback) ResponseCallback {
+	if progress == nil {
+		return nil
+	}
+	return func(resp Response) { progress(resp.Label(), resp.Duration, resp.Error) }
+}
 
-			startTime := time.Now()
-			
-			// Create context with timeout
-			askCtx, cancel := context.WithTimeout(ctx, timeout)
-			defer cancel()
+// AskMultipleModels asks the same question to multiple council members in parallel
+func (c *Client) AskMultipleModels(ctx context.Context, members []Member, question string, timeout time.Duration, progress ProgressCallback) []Response {
+	return c.AskMultipleModelsWithResponses(ctx, members, question, timeout, ProgressResponses(progress))
+}
 
-			resp := Response{Model: mdl}
+// AskMultipleModelsWithResponses is AskMultipleModels, handing each response
+// to onResponse as soon as it completes
+func (c *Client) AskMultipleModelsWithResponses(ctx context.Context, members []Member, question string, timeout time.Duration, onResponse ResponseCallback) []Response {
+	return askInParallel(members, c.maxConcurrent, onResponse, func(mbr Member) Response {
+		return c.askMember(ctx, mbr, question, timeout)
+	})
+}
 
-			// Create session
-			session, err := c.CreateSession(askCtx, mdl, false)
-			if err != nil {
-				resp.Error = err
-				resp.Duration = time.Since(startTime)
-				responses[idx] = resp
-				if progress != nil {
-					progress(mdl, resp.Duration, err)
-				}
-				return
-			}
-			defer func() {
-				if err := session.Destroy(); err != nil {
-					_ = err // Ignore error on cleanup
-				}
-			}()
-
-			// Setup event collection
-			done := make(chan bool)
-			var content string
-
-			session.On(func(event copilot.SessionEvent) {
-				if event.Type == "assistant.message" {
-					if event.Data.Content != nil {
-						content = *event.Data.Content
-					}
-				}
-				if event.Type == "session.idle" {
-					close(done)
-				}
-			})
-
-			// Send message
-			_, err = session.Send(copilot.MessageOptions{
-				Prompt: question,
-			})
-			if err != nil {
-				resp.Error = fmt.Errorf("failed to send message: %w", err)
-				resp.Duration = time.Since(startTime)
-				responses[idx] = resp
-				if progress != nil {
-					progress(mdl, resp.Duration, err)
-				}
-				return
-			}
+// askMember asks one member of AskMultipleModels
+func (c *Client) askMember(ctx context.Context, mbr Member, question string, timeout time.Duration) (resp Response) {
+	resp = Response{Member: mbr.Name, Model: mbr.Model}
+
+	// Hold back while the backend is rate limiting; the timeout
+	// starts once the request does
+	if err := c.throttle.acquire(ctx); err != nil {
+		resp.Error = err
+		return resp
+	}
+	defer func() { c.throttle.release(resp.Error) }()
+
+	startTime := time.Now()
+
+	// Create context with timeout
+	askCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Create session, or reuse the one made by Warmup
+	session, err := c.takeSession(askCtx, mbr)
+	if err != nil {
+		resp.Error = err
+		resp.ErrorDetail = ErrorDetailOf(err)
+		resp.Duration = time.Since(startTime)
+		return resp
+	}
+	defer c.destroySession(session, mbr.Model)
+
+	// Setup event collection
+	collector := newResponseCollector()
+	session.On(collector.Handle)
+
+	// Send message
+	collector.markSent()
+	_, err = session.Send(mbr.Options.messageOptions(question))
+	if err != nil {
+		resp.Error = fmt.Errorf("failed to send message: %w", withDetail(err, ""))
+		resp.ErrorDetail = ErrorDetailOf(resp.Error)
+		resp.Duration = time.Since(startTime)
+		return resp
+	}
+
+	// Wait for response or timeout
+	select {
+	case <-collector.Done():
+		resp.Content = collector.Content()
+		resp.Duration = time.Since(startTime)
+		resp.TimeToFirstToken = collector.TimeToFirstToken()
+		if err := collector.Err(); err != nil {
+			resp.Error = err
+			resp.ErrorDetail = ErrorDetailOf(err)
+			resp.Partial = resp.Content != ""
+		}
+	case <-askCtx.Done():
+		// Keep whatever streamed in before the timeout
+		resp.Content = collector.Content()
+		resp.Error = ErrTimeout
+		resp.Duration = time.Since(startTime)
+		resp.Partial = resp.Content != ""
+	}
+	return resp
+}
 
-			// Wait for response or timeout
-			select {
-			case <-done:
-				resp.Content = content
-				resp.Duration = time.Since(startTime)
-			case <-askCtx.Done():
-				resp.Error = fmt.Errorf("timeout waiting for response")
-				resp.Duration = time.Since(startTime)
+// askInParallel asks every member with ask, at most limit at a time (0
+// means all at once), and returns the responses in the members' order,
+// passing each to onResponse as it completes. Members start in order: with
+// a limit, each waits for a slot freed by an earlier one. A panic in ask
+// becomes that member's error, so that one bad request cannot take down the
+// others.
+func askInParallel(members []Member, limit int, onResponse ResponseCallback, ask func(Member) Response) []Response {
+	var wg sync.WaitGroup
+	responses := make([]Response, len(members))
+
+	var slots chan struct{}
+	if limit > 0 {
+		slots = make(chan struct{}, limit)
+	}
+	for i, member := range members {
+		if slots != nil {
+			slots <- struct{}{}
+		}
+		wg.Add(1)
+		go func(idx int, mbr Member) {
+			defer wg.Done()
+			if slots != nil {
+				defer func() { <-slots }()
 			}
 
+			resp := askRecovering(mbr, ask)
 			responses[idx] = resp
-			if progress != nil {
-				progress(mdl, resp.Duration, resp.Error)
+			if onResponse != nil {
+				onResponse(resp)
 			}
-		}(i, model)
+		}(i, member)
 	}
 
 	wg.Wait()
 	return responses
 }
 
-// AskSingleModel asks a question to a single model
-func (c *Client) AskSingleModel(ctx context.Context, model string, question string, timeout time.Duration) (string, time.Duration, error) {
+// askRecovering calls ask, turning a panic into a PanicError response
+func askRecovering(mbr Member, ask func(Member) Response) (resp Response) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp = Response{Member: mbr.Name, Model: mbr.Model, Error: NewPanicError(r)}
+		}
+	}()
+	return ask(mbr)
+}
+
+// AskSingleModel asks a question to a single council member
+func (c *Client) AskSingleModel(ctx context.Context, member Member, question string, timeout time.Duration) (content string, duration time.Duration, err error) {
+	if err := c.throttle.acquire(ctx); err != nil {
+		return "", 0, err
+	}
+	defer func() { c.throttle.release(err) }()
+
 	startTime := time.Now()
 	
 	askCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	session, err := c.CreateSession(askCtx, model, false)
+	session, err := c.CreateSession(askCtx, member.Model, member.SystemPrompt, false)
 	if err != nil {
 		return "", time.Since(startTime), err
 	}
-	defer func() {
-		if err := session.Destroy(); err != nil {
-			_ = err // Ignore error on cleanup
-		}
-	}()
+	defer c.destroySession(session, member.Model)
 
-	done := make(chan bool)
-	var content string
+	collector := newResponseCollector()
+	session.On(collector.Handle)
 
-	session.On(func(event copilot.SessionEvent) {
-		if event.Type == "assistant.message" {
-			if event.Data.Content != nil {
-				content = *event.Data.Content
-			}
-		}
-		if event.Type == "session.idle" {
-			close(done)
-		}
-	})
-
-	_, err = session.Send(copilot.MessageOptions{
-		Prompt: question,
-	})
+	_, err = session.Send(member.Options.messageOptions(question))
 	if err != nil {
-		return "", time.Since(startTime), fmt.Errorf("failed to send message: %w", err)
+		return "", time.Since(startTime), fmt.Errorf("failed to send message: %w", withDetail(err, ""))
 	}
 
 	select {
-	case <-done:
-		return content, time.Since(startTime), nil
+	case <-collector.Done():
+		if content := collector.Content(); content != "" || collector.Err() == nil {
+			return content, time.Since(startTime), nil
+		}
+		return "", time.Since(startTime), collector.Err()
 	case <-askCtx.Done():
-		return "", time.Since(startTime), fmt.Errorf("timeout waiting for response")
+		return "", time.Since(startTime), ErrTimeout
 	}
 }
@@ -0,0 +1,184 @@
+package copilot
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+// Typed errors reported by model calls. Use errors.Is to test for them.
+var (
+	// ErrTimeout reports a model that did not answer within the timeout
+	ErrTimeout = errors.New("timeout waiting for response")
+	// ErrSessionCreate reports a failure to open a session with the Copilot CLI
+	ErrSessionCreate = errors.New("failed to create session")
+	// ErrRateLimited reports a request rejected by rate limiting
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnknownModel reports a model name the backend does not recognize
+	ErrUnknownModel = errors.New("unknown model")
+	// ErrAuth reports missing or invalid Copilot credentials
+	ErrAuth = errors.New("authentication failed")
+	// ErrContextLength reports a prompt that exceeds the model's context window
+	ErrContextLength = errors.New("context length exceeded")
+)
+
+// PanicError is a panic recovered while asking a model. It becomes that
+// request's error so that one bad request cannot take down the others.
+type PanicError struct {
+	Value any
+	Stack []byte // The goroutine's stack where it panicked
+}
+
+// NewPanicError records a recovered panic value along with the current
+// stack; call it from the deferred function that recovered
+func NewPanicError(value any) *PanicError {
+	return &PanicError{Value: value, Stack: debug.Stack()}
+}
+
+func (e *PanicError) Error() string { return fmt.Sprintf("internal error: %v", e.Value) }
+
+// errorPatterns maps lowercase message fragments from the SDK to typed errors
+var errorPatterns = []struct {
+	kind      error
+	fragments []string
+}{
+	{ErrRateLimited, []string{"rate limit", "ratelimit", "too many requests", "429"}},
+	{ErrContextLength, []string{"context length", "context window", "maximum context", "prompt is too long", "too many tokens"}},
+	{ErrUnknownModel, []string{"unknown model", "model not found", "invalid model", "model is not supported", "unsupported model"}},
+	{ErrAuth, []string{"unauthorized", "not authenticated", "authentication", "401", "403", "forbidden"}},
+}
+
+// classifiedError pairs an error with the typed error it was classified as
+type classifiedError struct {
+	kind error
+	err  error
+}
+
+func (e *classifiedError) Error() string   { return e.err.Error() }
+func (e *classifiedError) Unwrap() []error { return []error{e.kind, e.err} }
+
+// classifyError tags err with the matching typed error, based on its message.
+// Errors that already match a typed error, or match none, are returned unchanged.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, p := range errorPatterns {
+		if errors.Is(err, p.kind) {
+			return err
+		}
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, p := range errorPatterns {
+		for _, fragment := range p.fragments {
+			if strings.Contains(msg, fragment) {
+				return &classifiedError{kind: p.kind, err: err}
+			}
+		}
+	}
+	return err
+}
+
+// ErrorDetail is the structured information known about a failed model call.
+// The SDK often reports only a message; fields it did not provide are empty.
+type ErrorDetail struct {
+	StatusCode int    // HTTP status from the provider
+	RequestID  string // Provider request ID, to quote in support tickets
+	Code       string // Error code or type, e.g. a JSON-RPC code
+	Message    string // The underlying error message
+}
+
+// detailedError attaches an ErrorDetail to an error
+type detailedError struct {
+	err    error
+	detail ErrorDetail
+}
+
+func (e *detailedError) Error() string { return e.err.Error() }
+func (e *detailedError) Unwrap() error { return e.err }
+
+// ErrorDetailOf returns the structured detail attached to err, or nil if there is none
+func ErrorDetailOf(err error) *ErrorDetail {
+	var de *detailedError
+	if !errors.As(err, &de) {
+		return nil
+	}
+	detail := de.detail
+	return &detail
+}
+
+// Patterns that find a status code and a request ID in a plain error message
+var (
+	statusPattern    = regexp.MustCompile(`(?i)\b(?:status(?:\s*code)?|http(?:/[\d.]+)?)\s*[:=]?\s*([1-5]\d{2})\b`)
+	requestIDPattern = regexp.MustCompile(`(?i)\b(?:x-)?(?:github-)?request[-_ ]?id"?\s*[:=]?\s*"?([A-Za-z0-9][A-Za-z0-9:._-]*)`)
+)
+
+// withDetail classifies err and attaches the structured detail found in it.
+// requestID, when known from the session's events, fills in a missing request ID.
+func withDetail(err error, requestID string) error {
+	if err == nil {
+		return nil
+	}
+	detail := extractDetail(err)
+	if detail.RequestID == "" {
+		detail.RequestID = requestID
+	}
+	return &detailedError{err: classifyError(err), detail: detail}
+}
+
+// extractDetail reads the detail of a JSON-RPC error from the SDK, falling back
+// to scanning the message for a status code and request ID
+func extractDetail(err error) ErrorDetail {
+	detail := ErrorDetail{Message: err.Error()}
+
+	var rpcErr *copilot.JSONRPCError
+	if errors.As(err, &rpcErr) {
+		detail.Message = rpcErr.Message
+		detail.Code = strconv.Itoa(rpcErr.Code)
+		detail.StatusCode = int(dataNumber(rpcErr.Data, "statusCode", "status"))
+		detail.RequestID = dataString(rpcErr.Data, "requestId", "request_id", "providerCallId", "apiCallId")
+	}
+
+	if detail.StatusCode == 0 {
+		if m := statusPattern.FindStringSubmatch(detail.Message); m != nil {
+			detail.StatusCode, _ = strconv.Atoi(m[1])
+		}
+	}
+	if detail.RequestID == "" {
+		if m := requestIDPattern.FindStringSubmatch(detail.Message); m != nil {
+			detail.RequestID = m[1]
+		}
+	}
+	return detail
+}
+
+// dataNumber returns the first of keys holding a number in a JSON-RPC error's data
+func dataNumber(data map[string]interface{}, keys ...string) float64 {
+	for _, key := range keys {
+		switch v := data[key].(type) {
+		case float64:
+			return v
+		case string:
+			if n, err := strconv.Atoi(v); err == nil {
+				return float64(n)
+			}
+		}
+	}
+	return 0
+}
+
+// dataString returns the first of keys holding a non-empty value in a JSON-RPC error's data
+func dataString(data map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if v, ok := data[key]; ok && v != nil && fmt.Sprint(v) != "" {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
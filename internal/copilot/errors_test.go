@@ -0,0 +1,79 @@
+package copilot
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want error
+	}{
+		{"HTTP 429: Too Many Requests", ErrRateLimited},
+		{"Model not found: gpt-9", ErrUnknownModel},
+		{"request failed: 401 Unauthorized", ErrAuth},
+		{"prompt is too long for this model's context window", ErrContextLength},
+		{"connection reset by peer", nil},
+	}
+
+	for _, tt := range tests {
+		err := classifyError(errors.New(tt.msg))
+		if tt.want == nil {
+			for _, p := range errorPatterns {
+				if errors.Is(err, p.kind) {
+					t.Errorf("%q: unexpectedly classified as %v", tt.msg, p.kind)
+				}
+			}
+			continue
+		}
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%q: expected %v", tt.msg, tt.want)
+		}
+		if err.Error() != tt.msg {
+			t.Errorf("%q: classification changed the message to %q", tt.msg, err.Error())
+		}
+	}
+
+	if classifyError(nil) != nil {
+		t.Error("classifyError(nil) should be nil")
+	}
+
+	wrapped := fmt.Errorf("send failed: %w", ErrAuth)
+	if got := classifyError(wrapped); got != wrapped {
+		t.Errorf("already typed errors should pass through unchanged, got %v", got)
+	}
+}
+
+func TestErrorDetail(t *testing.T) {
+	rpcErr := &copilot.JSONRPCError{
+		Code:    -32603,
+		Message: "rate limit exceeded",
+		Data:    map[string]interface{}{"statusCode": float64(429), "requestId": "abc-123"},
+	}
+	err := fmt.Errorf("failed to send message: %w", withDetail(fmt.Errorf("failed to send message: %w", rpcErr), ""))
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected the detailed error to keep its classification")
+	}
+	detail := ErrorDetailOf(err)
+	if detail == nil || detail.StatusCode != 429 || detail.RequestID != "abc-123" || detail.Code != "-32603" || detail.Message != "rate limit exceeded" {
+		t.Errorf("unexpected detail from a JSON-RPC error: %+v", detail)
+	}
+
+	detail = ErrorDetailOf(withDetail(errors.New("HTTP 503 Service Unavailable (request id: 7F3A:1234)"), ""))
+	if detail == nil || detail.StatusCode != 503 || detail.RequestID != "7F3A:1234" {
+		t.Errorf("expected the status and request ID parsed from the message, got %+v", detail)
+	}
+
+	detail = ErrorDetailOf(withDetail(errors.New("connection reset by peer"), "req-9"))
+	if detail == nil || detail.StatusCode != 0 || detail.RequestID != "req-9" || detail.Message != "connection reset by peer" {
+		t.Errorf("expected a message-only detail with the known request ID, got %+v", detail)
+	}
+
+	if ErrorDetailOf(errors.New("plain")) != nil {
+		t.Error("expected no detail on a plain error")
+	}
+}
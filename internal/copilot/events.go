@@ -0,0 +1,197 @@
+package copilot
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+// lateMessageGrace is how long a response that only streamed deltas waits
+// after session.idle for its complete assistant.message
+const lateMessageGrace = 500 * time.Millisecond
+
+// responseCollector accumulates a session's assistant output from its event stream.
+// Events may arrive in any order: deltas are buffered, every complete
+// assistant.message is kept, and the response is only finalized once
+// session.idle has been seen. When only deltas have arrived by then, it
+// waits for the complete message up to a grace period, since the deltas
+// alone may be cut short.
+type responseCollector struct {
+	mu       sync.Mutex
+	deltas   strings.Builder
+	messages []string
+	idle     bool          // session.idle was seen
+	finished bool          // done is closed
+	grace    time.Duration // See lateMessageGrace
+	timer    *time.Timer   // Ends the grace period
+	done     chan struct{}
+
+	sent       time.Time // When the prompt was sent, see markSent
+	firstToken time.Time // When the first assistant content arrived
+
+	err       *detailedError // Failure reported by a session.error event
+	requestID string         // Provider request ID from the usage report, if any
+}
+
+// newResponseCollector creates a collector ready to receive session events
+func newResponseCollector() *responseCollector {
+	return &responseCollector{
+		grace: lateMessageGrace,
+		done:  make(chan struct{}),
+	}
+}
+
+// Handle processes a single session event; it is safe to register with Session.On
+func (rc *responseCollector) Handle(event copilot.SessionEvent) {
+	// A panic here would unwind the SDK's goroutine and end the process
+	defer rc.recoverPanic()
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	switch event.Type {
+	case copilot.AssistantMessageDelta:
+		if event.Data.DeltaContent != nil {
+			rc.deltas.WriteString(*event.Data.DeltaContent)
+			rc.markFirstToken()
+		}
+	case copilot.AssistantMessage:
+		if event.Data.Content != nil && *event.Data.Content != "" {
+			rc.messages = append(rc.messages, *event.Data.Content)
+			rc.markFirstToken()
+			if rc.idle {
+				rc.finish()
+			}
+		}
+	case copilot.AssistantUsage:
+		if event.Data.ProviderCallID != nil {
+			rc.requestID = *event.Data.ProviderCallID
+		} else if event.Data.APICallID != nil {
+			rc.requestID = *event.Data.APICallID
+		}
+	case copilot.SessionError:
+		// The turn is over; the session may never report idle
+		if rc.err == nil {
+			rc.err = sessionError(event.Data)
+		}
+		rc.finish()
+	case copilot.SessionIdle:
+		rc.idle = true
+		if len(rc.messages) > 0 || rc.deltas.Len() == 0 {
+			rc.finish()
+		} else if rc.timer == nil && !rc.finished {
+			rc.timer = time.AfterFunc(rc.grace, func() {
+				rc.mu.Lock()
+				defer rc.mu.Unlock()
+				rc.finish()
+			})
+		}
+	}
+}
+
+// recoverPanic ends the response with a PanicError when handling an event
+// panicked; Handle defers it
+func (rc *responseCollector) recoverPanic() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.err == nil {
+		rc.err = &detailedError{err: NewPanicError(r)}
+	}
+	rc.finish()
+}
+
+// finish marks the response complete; callers hold rc.mu
+func (rc *responseCollector) finish() {
+	if rc.timer != nil {
+		rc.timer.Stop()
+	}
+	if !rc.finished {
+		rc.finished = true
+		close(rc.done)
+	}
+}
+
+// sessionError turns a session.error event into an error carrying its detail
+func sessionError(data copilot.Data) *detailedError {
+	message := "session error"
+	if data.Message != nil && *data.Message != "" {
+		message = *data.Message
+	}
+	err := errors.New(message)
+	detail := extractDetail(err)
+	if data.ErrorType != nil {
+		detail.Code = *data.ErrorType
+	}
+	return &detailedError{err: classifyError(err), detail: detail}
+}
+
+// Err returns the error the session reported, carrying any request ID seen
+// in the session's usage report, or nil
+func (rc *responseCollector) Err() error {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.err == nil {
+		return nil
+	}
+	if rc.err.detail.RequestID == "" {
+		rc.err.detail.RequestID = rc.requestID
+	}
+	return rc.err
+}
+
+// RequestID returns the provider request ID from the session's usage report, if any
+func (rc *responseCollector) RequestID() string {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.requestID
+}
+
+// Done is closed once the session reports it is idle and the complete
+// message is in, or the grace period for it has passed
+func (rc *responseCollector) Done() <-chan struct{} {
+	return rc.done
+}
+
+// Content returns the final response: the last complete assistant message,
+// or the concatenated deltas when no complete message was received
+func (rc *responseCollector) Content() string {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if len(rc.messages) > 0 {
+		return rc.messages[len(rc.messages)-1]
+	}
+	return rc.deltas.String()
+}
+
+// markSent records when the prompt was sent, the start of time-to-first-token
+func (rc *responseCollector) markSent() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.sent = time.Now()
+}
+
+// markFirstToken timestamps the first assistant content; callers hold rc.mu
+func (rc *responseCollector) markFirstToken() {
+	if rc.firstToken.IsZero() {
+		rc.firstToken = time.Now()
+	}
+}
+
+// TimeToFirstToken returns how long after markSent the first assistant content
+// arrived, or 0 if none has. On a non-streaming session the first content is
+// the complete message, so this is close to the full generation time.
+func (rc *responseCollector) TimeToFirstToken() time.Duration {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.sent.IsZero() || rc.firstToken.IsZero() {
+		return 0
+	}
+	return rc.firstToken.Sub(rc.sent)
+}
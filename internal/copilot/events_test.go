@@ -0,0 +1,167 @@
+package copilot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+func event(eventType copilot.SessionEventType, content, delta string) copilot.SessionEvent {
+	ev := copilot.SessionEvent{Type: eventType}
+	if content != "" {
+		ev.Data.Content = &content
+	}
+	if delta != "" {
+		ev.Data.DeltaContent = &delta
+	}
+	return ev
+}
+
+func TestResponseCollectorOutOfOrderEvents(t *testing.T) {
+	t.Run("deltas only", func(t *testing.T) {
+		rc := newResponseCollector()
+		rc.Handle(event(copilot.AssistantMessageDelta, "", "Hello, "))
+		rc.Handle(event(copilot.AssistantMessageDelta, "", "world"))
+		rc.Handle(event(copilot.SessionIdle, "", ""))
+
+		if got := rc.Content(); got != "Hello, world" {
+			t.Errorf("expected accumulated deltas, got %q", got)
+		}
+	})
+
+	t.Run("message arrives after idle", func(t *testing.T) {
+		// Read the response the way askMember does: as soon as Done closes,
+		// while the SDK is still delivering events on its own goroutine
+		rc := newResponseCollector()
+		go func() {
+			rc.Handle(event(copilot.AssistantMessageDelta, "", "Hel"))
+			rc.Handle(event(copilot.SessionIdle, "", ""))
+			time.Sleep(20 * time.Millisecond)
+			rc.Handle(event(copilot.AssistantMessage, "Hello, world", ""))
+		}()
+
+		select {
+		case <-rc.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("Done was never closed")
+		}
+		if got := rc.Content(); got != "Hello, world" {
+			t.Errorf("expected late complete message, got %q", got)
+		}
+	})
+
+	t.Run("deltas only wait out the grace period", func(t *testing.T) {
+		rc := newResponseCollector()
+		rc.grace = 50 * time.Millisecond
+		start := time.Now()
+		go func() {
+			rc.Handle(event(copilot.AssistantMessageDelta, "", "Hello"))
+			rc.Handle(event(copilot.SessionIdle, "", ""))
+		}()
+
+		select {
+		case <-rc.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("Done was never closed")
+		}
+		if elapsed := time.Since(start); elapsed < rc.grace {
+			t.Errorf("Done closed after %v, before the %v grace period", elapsed, rc.grace)
+		}
+		if got := rc.Content(); got != "Hello" {
+			t.Errorf("expected the deltas, got %q", got)
+		}
+	})
+
+	t.Run("multiple messages keep the last complete one", func(t *testing.T) {
+		rc := newResponseCollector()
+		rc.Handle(event(copilot.AssistantMessage, "Let me think about that.", ""))
+		rc.Handle(event(copilot.AssistantMessage, "", ""))
+		rc.Handle(event(copilot.AssistantMessage, "The answer is 42.", ""))
+		rc.Handle(event(copilot.AssistantMessage, "", ""))
+		rc.Handle(event(copilot.SessionIdle, "", ""))
+
+		if got := rc.Content(); got != "The answer is 42." {
+			t.Errorf("expected last complete message, got %q", got)
+		}
+	})
+
+	t.Run("duplicate idle does not panic", func(t *testing.T) {
+		rc := newResponseCollector()
+		rc.Handle(event(copilot.SessionIdle, "", ""))
+		rc.Handle(event(copilot.SessionIdle, "", ""))
+
+		select {
+		case <-rc.Done():
+		default:
+			t.Error("expected Done to be closed after idle")
+		}
+	})
+}
+
+func TestResponseCollectorTimeToFirstToken(t *testing.T) {
+	t.Run("delayed first chunk", func(t *testing.T) {
+		rc := newResponseCollector()
+		rc.markSent()
+		if got := rc.TimeToFirstToken(); got != 0 {
+			t.Errorf("expected 0 before any content, got %v", got)
+		}
+
+		// A fake session that takes a while to start generating
+		time.Sleep(30 * time.Millisecond)
+		rc.Handle(event(copilot.AssistantMessageDelta, "", "Hel"))
+		first := rc.TimeToFirstToken()
+		time.Sleep(30 * time.Millisecond)
+		rc.Handle(event(copilot.AssistantMessageDelta, "", "lo"))
+		rc.Handle(event(copilot.AssistantMessage, "Hello", ""))
+		rc.Handle(event(copilot.SessionIdle, "", ""))
+
+		if first < 30*time.Millisecond {
+			t.Errorf("TimeToFirstToken() = %v, want at least the 30ms delay", first)
+		}
+		if got := rc.TimeToFirstToken(); got != first {
+			t.Errorf("later chunks moved TimeToFirstToken from %v to %v", first, got)
+		}
+	})
+
+	t.Run("empty message is not a token", func(t *testing.T) {
+		rc := newResponseCollector()
+		rc.markSent()
+		rc.Handle(event(copilot.AssistantMessage, "", ""))
+		if got := rc.TimeToFirstToken(); got != 0 {
+			t.Errorf("expected 0 for an empty message, got %v", got)
+		}
+	})
+}
+
+func TestResponseCollectorSessionError(t *testing.T) {
+	rc := newResponseCollector()
+	callID := "prov-42"
+	usage := event(copilot.AssistantUsage, "", "")
+	usage.Data.ProviderCallID = &callID
+	rc.Handle(usage)
+
+	message, errorType := "Too Many Requests (status 429)", "rate_limit"
+	failure := event(copilot.SessionError, "", "")
+	failure.Data.Message = &message
+	failure.Data.ErrorType = &errorType
+	rc.Handle(failure)
+
+	select {
+	case <-rc.Done():
+	default:
+		t.Fatal("expected a session error to finish the response")
+	}
+	err := rc.Err()
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected the session error to be classified, got %v", err)
+	}
+	detail := ErrorDetailOf(err)
+	if detail == nil || detail.Code != "rate_limit" || detail.StatusCode != 429 || detail.RequestID != "prov-42" {
+		t.Errorf("unexpected detail %+v", detail)
+	}
+
+	// A later idle event must not close Done twice
+	rc.Handle(event(copilot.SessionIdle, "", ""))
+}
@@ -0,0 +1,201 @@
+package copilot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MockResponse is one scripted reply from a mock model
+type MockResponse struct {
+	Content    string `json:"content,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int    `json:"duration_ms,omitempty"`
+	// FirstTokenMs is the time to first token; 0 means the whole duration, as without streaming
+	FirstTokenMs int `json:"first_token_ms,omitempty"`
+	// RequestID is reported in the ErrorDetail of a scripted error
+	RequestID string `json:"request_id,omitempty"`
+	// Partial is the content streamed before a scripted error
+	Partial string `json:"partial,omitempty"`
+}
+
+// MockFixture scripts the replies of a MockClient, by member name or else model.
+// Each model replies with its entries in order, repeating the last one once
+// the script is exhausted. Models without a script get a canned reply.
+type MockFixture struct {
+	Models map[string][]MockResponse `json:"models"`
+}
+
+// LoadMockFixture reads a MockFixture from a JSON file
+func LoadMockFixture(path string) (*MockFixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mock fixture: %w", err)
+	}
+
+	var fixture MockFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse mock fixture %s: %w", path, err)
+	}
+	return &fixture, nil
+}
+
+// MockClient is a deterministic, offline stand-in for Client
+type MockClient struct {
+	fixture *MockFixture
+	// simulate controls whether canned durations are actually waited out
+	simulate bool
+
+	mu    sync.Mutex
+	calls map[string]int
+
+	maxConcurrent int // See SetMaxConcurrency
+}
+
+// NewMockClient creates a mock client. A nil fixture uses canned replies for every model.
+// When simulate is true, the client sleeps for each reply's duration so progress
+// output behaves like a real run.
+func NewMockClient(fixture *MockFixture, simulate bool) *MockClient {
+	if fixture == nil {
+		fixture = &MockFixture{}
+	}
+	return &MockClient{
+		fixture:  fixture,
+		simulate: simulate,
+		calls:    make(map[string]int),
+	}
+}
+
+// Close is a no-op for the mock client
+func (m *MockClient) Close() error {
+	return nil
+}
+
+// Warmup is a no-op: mock members have no sessions to create
+func (m *MockClient) Warmup(ctx context.Context, members []Member, timeout time.Duration) error {
+	return nil
+}
+
+// SetMaxConcurrency caps how many members AskMultipleModels asks at once, like Client.SetMaxConcurrency
+func (m *MockClient) SetMaxConcurrency(n int) {
+	m.maxConcurrent = n
+}
+
+// AskMultipleModels asks the same question to multiple mock members in parallel
+func (m *MockClient) AskMultipleModels(ctx context.Context, members []Member, question string, timeout time.Duration, progress ProgressCallback) []Response {
+	return m.AskMultipleModelsWithResponses(ctx, members, question, timeout, ProgressResponses(progress))
+}
+
+// AskMultipleModelsWithResponses is AskMultipleModels, handing each response
+// to onResponse as soon as it completes
+func (m *MockClient) AskMultipleModelsWithResponses(ctx context.Context, members []Member, question string, timeout time.Duration, onResponse ResponseCallback) []Response {
+	return askInParallel(members, m.maxConcurrent, onResponse, func(mbr Member) Response {
+		content, duration, firstToken, err := m.ask(ctx, mbr, question, timeout)
+		return Response{
+			Member:           mbr.Name,
+			Model:            mbr.Model,
+			Content:          content,
+			Error:            err,
+			Duration:         duration,
+			TimeToFirstToken: firstToken,
+			ErrorDetail:      ErrorDetailOf(err),
+			Partial:          err != nil && content != "",
+		}
+	})
+}
+
+// AskSingleModel returns the next scripted reply for the member
+func (m *MockClient) AskSingleModel(ctx context.Context, member Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	content, duration, _, err := m.ask(ctx, member, question, timeout)
+	return content, duration, err
+}
+
+// ask plays the member's next scripted reply, also returning its time to first token
+func (m *MockClient) ask(ctx context.Context, member Member, question string, timeout time.Duration) (string, time.Duration, time.Duration, error) {
+	reply := m.next(member, question)
+	duration := time.Duration(reply.DurationMs) * time.Millisecond
+	firstToken := duration
+	if reply.FirstTokenMs > 0 && reply.FirstTokenMs < reply.DurationMs {
+		firstToken = time.Duration(reply.FirstTokenMs) * time.Millisecond
+	}
+
+	if m.simulate {
+		wait := duration
+		if timeout > 0 && wait > timeout {
+			wait = timeout
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return "", wait, 0, ctx.Err()
+		}
+	}
+
+	if timeout > 0 && duration > timeout {
+		return "", timeout, 0, ErrTimeout
+	}
+	if reply.Error != "" {
+		return reply.Partial, duration, firstToken, withDetail(errors.New(reply.Error), reply.RequestID)
+	}
+	return reply.Content, duration, firstToken, nil
+}
+
+// next picks the scripted or canned reply for the member's next call
+func (m *MockClient) next(member Member, prompt string) MockResponse {
+	key := member.Name
+	if _, ok := m.fixture.Models[key]; !ok || key == "" {
+		key = member.Model
+	}
+
+	m.mu.Lock()
+	call := m.calls[key]
+	m.calls[key]++
+	m.mu.Unlock()
+
+	if script := m.fixture.Models[key]; len(script) > 0 {
+		if call >= len(script) {
+			call = len(script) - 1
+		}
+		return script[call]
+	}
+	name := member.Name
+	if name == "" {
+		name = member.Model
+	}
+	return cannedReply(name, prompt)
+}
+
+// reviewLabelPattern finds the anonymized response labels in a review prompt
+var reviewLabelPattern = regexp.MustCompile(`(?m)^## Response ([^:\n]+):`)
+
+// cannedReply builds a deterministic reply that fits the kind of prompt received
+func cannedReply(model, prompt string) MockResponse {
+	h := fnv.New32a()
+	h.Write([]byte(model))
+	duration := 800 + int(h.Sum32()%1700)
+
+	if strings.HasSuffix(prompt, "\nCHOICE: <number>") {
+		return MockResponse{Content: fmt.Sprintf("Response 1 is the most complete, says %s.\nCHOICE: 1", model), DurationMs: duration / 4}
+	}
+	if labels := reviewLabelPattern.FindAllStringSubmatch(prompt, -1); len(labels) > 0 {
+		var sb strings.Builder
+		sb.WriteString("Ranking:\n")
+		for i, label := range labels {
+			sb.WriteString(fmt.Sprintf("%d. Response %s: mock evaluation by %s\n", i+1, label[1], model))
+		}
+		return MockResponse{Content: sb.String(), DurationMs: duration / 2}
+	}
+
+	return MockResponse{
+		Content:      fmt.Sprintf("This is a mock answer from %s. It is deterministic and generated without contacting any model backend.", model),
+		DurationMs:   duration,
+		FirstTokenMs: duration / 4,
+	}
+}
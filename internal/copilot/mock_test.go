@@ -0,0 +1,207 @@
+package copilot
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMockClientScript(t *testing.T) {
+	client := NewMockClient(&MockFixture{
+		Models: map[string][]MockResponse{
+			"model-a": {
+				{Content: "first", DurationMs: 10},
+				{Content: "second", DurationMs: 20},
+			},
+			"model-b": {
+				{Error: "rate limit exceeded"},
+			},
+		},
+	}, false)
+
+	ctx := context.Background()
+
+	content, duration, err := client.AskSingleModel(ctx, ModelMember("model-a"), "q", time.Minute)
+	if err != nil || content != "first" || duration != 10*time.Millisecond {
+		t.Errorf("first call: got %q, %v, %v", content, duration, err)
+	}
+	content, _, _ = client.AskSingleModel(ctx, ModelMember("model-a"), "q", time.Minute)
+	if content != "second" {
+		t.Errorf("second call: got %q", content)
+	}
+	content, _, _ = client.AskSingleModel(ctx, ModelMember("model-a"), "q", time.Minute)
+	if content != "second" {
+		t.Errorf("exhausted script should repeat the last entry, got %q", content)
+	}
+
+	if _, _, err := client.AskSingleModel(ctx, ModelMember("model-b"), "q", time.Minute); err == nil || err.Error() != "rate limit exceeded" {
+		t.Errorf("expected scripted error, got %v", err)
+	}
+}
+
+func TestMockClientTimeout(t *testing.T) {
+	client := NewMockClient(&MockFixture{
+		Models: map[string][]MockResponse{
+			"slow": {{Content: "late", DurationMs: 5000}},
+		},
+	}, false)
+
+	_, duration, err := client.AskSingleModel(context.Background(), ModelMember("slow"), "q", time.Second)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+	if duration != time.Second {
+		t.Errorf("expected duration capped at timeout, got %v", duration)
+	}
+}
+
+func TestMockClientCannedReplies(t *testing.T) {
+	client := NewMockClient(nil, false)
+	ctx := context.Background()
+
+	responses := client.AskMultipleModels(ctx, ModelMembers([]string{"model-a", "model-b"}), "What is Go?", time.Minute, nil)
+	for _, resp := range responses {
+		if resp.Error != nil || !strings.Contains(resp.Content, resp.Model) {
+			t.Errorf("unexpected canned response: %+v", resp)
+		}
+	}
+
+	review, _, err := client.AskSingleModel(ctx, ModelMember("model-a"), "## Response A:\nfoo\n\n## Response B:\nbar\n", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(review, "1. Response A") || !strings.Contains(review, "2. Response B") {
+		t.Errorf("expected canned ranking, got %q", review)
+	}
+
+	judged, _, err := client.AskSingleModel(ctx, ModelMember("model-a"), "## Response 1:\nfoo\n\n## Response 2:\nbar\n\nWhich one?\nCHOICE: <number>", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(judged, "\nCHOICE: 1") {
+		t.Errorf("expected a canned CHOICE line, got %q", judged)
+	}
+}
+
+func TestMockClientPersonas(t *testing.T) {
+	client := NewMockClient(&MockFixture{
+		Models: map[string][]MockResponse{
+			"skeptic": {{Content: "persona script"}},
+			"model-a": {{Content: "model script"}},
+		},
+	}, false)
+
+	members := []Member{
+		{Name: "skeptic", Model: "model-a", SystemPrompt: "Doubt everything."},
+		{Name: "optimist", Model: "model-a", SystemPrompt: "Look on the bright side."},
+	}
+	responses := client.AskMultipleModels(context.Background(), members, "q", time.Minute, nil)
+
+	if responses[0].Label() != "skeptic" || responses[0].Model != "model-a" || responses[0].Content != "persona script" {
+		t.Errorf("persona script not used: %+v", responses[0])
+	}
+	if responses[1].Label() != "optimist" || responses[1].Content != "model script" {
+		t.Errorf("expected fallback to the model script: %+v", responses[1])
+	}
+}
+
+func TestMockClientProgress(t *testing.T) {
+	client := NewMockClient(&MockFixture{
+		Models: map[string][]MockResponse{"model-b": {{Error: "boom"}}},
+	}, false)
+	members := []Member{{Name: "skeptic", Model: "model-a"}, ModelMember("model-b")}
+
+	var mu sync.Mutex
+	reported := map[string]error{}
+	client.AskMultipleModels(context.Background(), members, "q", time.Minute, func(model string, duration time.Duration, err error) {
+		mu.Lock()
+		reported[model] = err
+		mu.Unlock()
+	})
+	if err, ok := reported["skeptic"]; !ok || err != nil {
+		t.Errorf("expected skeptic reported without error, got %v", reported)
+	}
+	if err := reported["model-b"]; err == nil || err.Error() != "boom" {
+		t.Errorf("expected model-b reported with its error, got %v", reported)
+	}
+}
+
+func TestMockClientTimeToFirstToken(t *testing.T) {
+	client := NewMockClient(&MockFixture{
+		Models: map[string][]MockResponse{
+			"streaming": {{Content: "ok", DurationMs: 100, FirstTokenMs: 20}},
+			"blocking":  {{Content: "ok", DurationMs: 100}},
+		},
+	}, false)
+
+	responses := client.AskMultipleModels(context.Background(), ModelMembers([]string{"streaming", "blocking"}), "q", time.Minute, nil)
+	if got := responses[0].TimeToFirstToken; got != 20*time.Millisecond {
+		t.Errorf("streaming TimeToFirstToken = %v, want 20ms", got)
+	}
+	if got := responses[1].TimeToFirstToken; got != responses[1].Duration {
+		t.Errorf("without a first token time, TimeToFirstToken = %v, want the full duration %v", got, responses[1].Duration)
+	}
+}
+
+func TestAskInParallelLimit(t *testing.T) {
+	members := ModelMembers([]string{"fast", "medium", "slow"})
+	var (
+		mu      sync.Mutex
+		order   []string
+		running int
+	)
+	askInParallel(members, 1, nil, func(mbr Member) Response {
+		mu.Lock()
+		running++
+		if running > 1 {
+			t.Errorf("%s asked while %d requests were running, want at most 1", mbr.Model, running-1)
+		}
+		order = append(order, mbr.Model)
+		mu.Unlock()
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return Response{Member: mbr.Name, Model: mbr.Model}
+	})
+	if strings.Join(order, ",") != "fast,medium,slow" {
+		t.Errorf("expected members granted slots in order, got %v", order)
+	}
+}
+
+func TestAskInParallelRecoversPanic(t *testing.T) {
+	members := []Member{ModelMember("model-a"), ModelMember("model-b"), ModelMember("model-c")}
+	var mu sync.Mutex
+	var reported []string
+	responses := askInParallel(members, 0, func(resp Response) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported = append(reported, resp.Model)
+	}, func(mbr Member) Response {
+		if mbr.Model == "model-b" {
+			var handler map[string]func()
+			handler["session.idle"]() // nil map: panics like a bad SDK event
+		}
+		return Response{Member: mbr.Name, Model: mbr.Model, Content: "answer from " + mbr.Model}
+	})
+
+	if len(responses) != 3 || len(reported) != 3 {
+		t.Fatalf("expected every member to report, got %d responses and %d callbacks", len(responses), len(reported))
+	}
+	for _, i := range []int{0, 2} {
+		if responses[i].Error != nil || responses[i].Content != "answer from "+members[i].Model {
+			t.Errorf("%s should still answer, got %+v", members[i].Model, responses[i])
+		}
+	}
+
+	var panicErr *PanicError
+	if !errors.As(responses[1].Error, &panicErr) || responses[1].Model != "model-b" {
+		t.Fatalf("expected a PanicError for model-b, got %+v", responses[1])
+	}
+	if !strings.HasPrefix(panicErr.Error(), "internal error: ") || !strings.Contains(string(panicErr.Stack), "TestAskInParallelRecoversPanic") {
+		t.Errorf("expected the panic and its stack, got %q\n%s", panicErr.Error(), panicErr.Stack)
+	}
+}
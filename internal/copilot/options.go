@@ -0,0 +1,69 @@
+package copilot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+// SendOptions are per-model overrides merged into the MessageOptions of every
+// prompt sent to that model
+type SendOptions struct {
+	Mode        string               // Message delivery mode
+	Attachments []copilot.Attachment // Files or directories attached to every prompt
+}
+
+// SendOptionKeys lists the keys accepted by SendOptions.Set
+var SendOptionKeys = []string{"mode", "attachment"}
+
+// unsupportedOptions are generation parameters people expect but the Copilot
+// SDK does not expose, so they get a clearer error than an unknown key
+var unsupportedOptions = map[string]bool{
+	"max_tokens":        true,
+	"max_output_tokens": true,
+	"stop":              true,
+	"top_p":             true,
+	"temperature":       true,
+}
+
+// Set applies one key=value option
+func (o *SendOptions) Set(key, value string) error {
+	key = strings.ToLower(strings.TrimSpace(key))
+	value = strings.TrimSpace(value)
+
+	switch key {
+	case "mode":
+		if value == "" {
+			return fmt.Errorf("option mode needs a value")
+		}
+		o.Mode = value
+	case "attachment":
+		info, err := os.Stat(value)
+		if err != nil {
+			return fmt.Errorf("invalid attachment: %w", err)
+		}
+		attachment := copilot.Attachment{Path: value, DisplayName: filepath.Base(value), Type: copilot.File}
+		if info.IsDir() {
+			attachment.Type = copilot.Directory
+		}
+		o.Attachments = append(o.Attachments, attachment)
+	default:
+		if unsupportedOptions[key] {
+			return fmt.Errorf("option %s is not supported by the Copilot SDK", key)
+		}
+		return fmt.Errorf("unknown option %q (expected one of: %s)", key, strings.Join(SendOptionKeys, ", "))
+	}
+	return nil
+}
+
+// messageOptions builds the MessageOptions sent with a prompt
+func (o SendOptions) messageOptions(prompt string) copilot.MessageOptions {
+	return copilot.MessageOptions{
+		Prompt:      prompt,
+		Mode:        o.Mode,
+		Attachments: o.Attachments,
+	}
+}
@@ -0,0 +1,49 @@
+package copilot
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+func TestSendOptionsSet(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "notes.md")
+	if err := os.WriteFile(file, []byte("notes"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var opts SendOptions
+	for key, value := range map[string]string{"mode": "immediate", "attachment": file} {
+		if err := opts.Set(key, value); err != nil {
+			t.Fatalf("Set(%s): %v", key, err)
+		}
+	}
+	if err := opts.Set("Attachment", dir); err != nil {
+		t.Fatalf("Set(attachment dir): %v", err)
+	}
+
+	msg := opts.messageOptions("What is Go?")
+	if msg.Prompt != "What is Go?" || msg.Mode != "immediate" {
+		t.Errorf("unexpected message options: %+v", msg)
+	}
+	if len(msg.Attachments) != 2 || msg.Attachments[0].Type != copilot.File || msg.Attachments[1].Type != copilot.Directory {
+		t.Errorf("unexpected attachments: %+v", msg.Attachments)
+	}
+
+	for key, want := range map[string]string{
+		"max_tokens": "not supported by the Copilot SDK",
+		"top_p":      "not supported by the Copilot SDK",
+		"verbosity":  "unknown option",
+	} {
+		if err := opts.Set(key, "1"); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Set(%s): expected %q error, got %v", key, want, err)
+		}
+	}
+	if err := opts.Set("attachment", filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for a missing attachment")
+	}
+}
@@ -0,0 +1,117 @@
+package copilot
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Adaptive throttling: requests run without a concurrency limit until the
+// backend rate limits several of them in a row. Then the limit drops to half
+// of the requests in flight and new requests wait out a backoff. Every
+// successful request raises the limit by one again.
+const (
+	throttleStrikes    = 2                // Rate-limited requests in a row that trigger throttling
+	throttleBackoff    = time.Second      // First backoff; doubles with each further strike
+	throttleMaxBackoff = 30 * time.Second // Longest backoff
+)
+
+// ThrottleHandler is called when rate limiting lowers the concurrency
+// limit. limit is the number of requests now allowed at once and backoff how
+// long new requests are held back.
+type ThrottleHandler func(limit int, backoff time.Duration)
+
+// throttle is the rate-limit sensor shared by every request of a Client
+type throttle struct {
+	mu      sync.Mutex
+	limit   int           // Requests allowed at once; 0 means no limit
+	active  int           // Requests in flight
+	strikes int           // Rate-limited requests since the last success
+	until   time.Time     // New requests wait until then
+	wake    chan struct{} // Closed when waiting requests should look again
+	handler ThrottleHandler
+}
+
+func newThrottle() *throttle {
+	return &throttle{wake: make(chan struct{})}
+}
+
+// acquire waits until a request may start, or ctx is done
+func (t *throttle) acquire(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		wait := time.Until(t.until)
+		if wait <= 0 && (t.limit == 0 || t.active < t.limit) {
+			t.active++
+			t.mu.Unlock()
+			return nil
+		}
+		wake := t.wake
+		t.mu.Unlock()
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			expired = timer.C
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return ctx.Err()
+		case <-wake:
+		case <-expired:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// release ends a request, adapting the limit to how it went
+func (t *throttle) release(err error) {
+	t.mu.Lock()
+	t.active--
+
+	var notify ThrottleHandler
+	var limit int
+	var backoff time.Duration
+	switch {
+	case errors.Is(err, ErrRateLimited):
+		t.strikes++
+		if t.strikes >= throttleStrikes {
+			inFlight := t.active + 1
+			if t.limit > 0 && t.limit < inFlight {
+				inFlight = t.limit
+			}
+			t.limit = max(1, inFlight/2)
+			backoff = min(throttleBackoff<<min(t.strikes-throttleStrikes, 5), throttleMaxBackoff)
+			t.until = time.Now().Add(backoff)
+			notify, limit = t.handler, t.limit
+		}
+	case err == nil:
+		t.strikes = 0
+		if t.limit > 0 {
+			t.limit++
+		}
+	}
+
+	// Let waiting requests check the new state
+	close(t.wake)
+	t.wake = make(chan struct{})
+	t.mu.Unlock()
+
+	if notify != nil {
+		notify(limit, backoff)
+	}
+}
+
+// setHandler sets the function told about throttling
+func (t *throttle) setHandler(handler ThrottleHandler) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handler = handler
+}
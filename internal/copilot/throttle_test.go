@@ -0,0 +1,81 @@
+package copilot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	th := newThrottle()
+	var limits []int
+	var backoffs []time.Duration
+	th.setHandler(func(limit int, backoff time.Duration) {
+		limits = append(limits, limit)
+		backoffs = append(backoffs, backoff)
+	})
+
+	for i := 0; i < 4; i++ {
+		if err := th.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// One rate-limited request is not enough to throttle
+	rateLimited := fmt.Errorf("send failed: %w", ErrRateLimited)
+	th.release(rateLimited)
+	if len(limits) != 0 {
+		t.Fatalf("throttled after a single rate limit: %v", limits)
+	}
+	th.release(rateLimited)
+	if len(limits) != 1 || limits[0] != 1 || backoffs[0] != throttleBackoff {
+		t.Fatalf("expected the limit halved to 1 with a %v backoff, got %v %v", throttleBackoff, limits, backoffs)
+	}
+
+	// New requests wait out the backoff
+	if err := acquireWithin(th, 20*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected to wait out the backoff, got %v", err)
+	}
+
+	// Past the backoff, the two requests in flight still exceed the limit
+	th.mu.Lock()
+	th.until = time.Time{}
+	th.mu.Unlock()
+	if err := acquireWithin(th, 20*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected to wait for a free slot, got %v", err)
+	}
+
+	// A waiting request starts as soon as enough requests finish
+	started := make(chan error, 1)
+	go func() { started <- acquireWithin(th, time.Second) }()
+	th.release(nil)
+	th.release(nil)
+	if err := <-started; err != nil {
+		t.Errorf("expected the waiting request to start, got %v", err)
+	}
+	if th.limit != 3 {
+		t.Errorf("expected each success to raise the limit, got %d", th.limit)
+	}
+}
+
+func TestThrottleOtherErrors(t *testing.T) {
+	th := newThrottle()
+	for i := 0; i < 3; i++ {
+		if err := th.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		th.release(ErrTimeout)
+	}
+	if th.limit != 0 || !th.until.IsZero() {
+		t.Errorf("only rate limits should throttle, got limit %d until %v", th.limit, th.until)
+	}
+}
+
+// acquireWithin tries to acquire th for at most d
+func acquireWithin(th *throttle, d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return th.acquire(ctx)
+}
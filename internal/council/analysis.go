@@ -0,0 +1,209 @@
+package council
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// Sentiment labels of AnalyzeResponse
+const (
+	SentimentPositive = "positive"
+	SentimentNegative = "negative"
+	SentimentNeutral  = "neutral"
+)
+
+// AnalyzeResponse fills in the word count, language and sentiment of an
+// answer. Failed and empty answers are returned as they are.
+func AnalyzeResponse(resp copilot.Response) copilot.Response {
+	if resp.Error != nil || resp.Content == "" {
+		return resp
+	}
+	words := analysisWords(resp.Content)
+	resp.WordCount = len(words)
+	resp.DetectedLanguage = detectLanguage(words)
+	if resp.DetectedLanguage == "en" {
+		resp.Sentiment = englishSentiment(words)
+	}
+	return resp
+}
+
+// analyzeResponses runs AnalyzeResponse over every answer in place
+func analyzeResponses(responses []copilot.Response) {
+	for i := range responses {
+		responses[i] = AnalyzeResponse(responses[i])
+	}
+}
+
+// isCJK reports whether r is a Chinese character or Japanese kana, scripts
+// written without spaces between words
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r)
+}
+
+// analysisWords splits text into lowercased words. Runs of letters and
+// digits are words, kept whole across an apostrophe, hyphen or dot between
+// them ("it's", "well-known", "1.24"); each Chinese character or kana
+// counts as a word of its own, as those scripts do not separate words with
+// spaces.
+func analysisWords(text string) []string {
+	var words []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+	runes := []rune(strings.ToLower(text))
+	for i, r := range runes {
+		switch {
+		case isCJK(r):
+			flush()
+			words = append(words, string(r))
+		case isWordRune(r):
+			current.WriteRune(r)
+		case strings.ContainsRune("'’-.", r) && current.Len() > 0 && i+1 < len(runes) && isWordRune(runes[i+1]) && !isCJK(runes[i+1]):
+			current.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words
+}
+
+// isWordRune reports whether r belongs in a word
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r)
+}
+
+// scriptLanguages names the language of scripts used by about one language
+var scriptLanguages = []struct {
+	script   *unicode.RangeTable
+	language string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Devanagari, "hi"},
+	{unicode.Thai, "th"},
+}
+
+// latinStopwords holds frequent short words of languages written in the
+// Latin script; the language with the most of them in a text wins
+var latinStopwords = map[string][]string{
+	"en": {"the", "and", "is", "are", "of", "to", "in", "it", "that", "with", "for", "this", "you", "not"},
+	"es": {"el", "la", "los", "las", "y", "es", "de", "que", "en", "un", "una", "por", "con", "para"},
+	"fr": {"le", "la", "les", "et", "est", "de", "des", "que", "un", "une", "pour", "avec", "dans", "pas"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "mit", "zu", "den", "für", "auf", "sie"},
+	"pt": {"o", "os", "as", "e", "é", "de", "que", "em", "um", "uma", "para", "com", "não", "do"},
+	"it": {"il", "lo", "gli", "e", "è", "di", "che", "in", "un", "una", "per", "con", "non", "sono"},
+}
+
+// detectLanguage guesses the ISO 639-1 code of the language words are
+// written in, from the script most of them use and, for the Latin script,
+// from common words. Words rather than letters are counted so that a
+// character of Japanese weighs as much as a word of code in its prose. It
+// returns "" when it cannot tell.
+func detectLanguage(words []string) string {
+	var kana, han, latin int
+	scripts := make(map[string]int)
+	for _, word := range words {
+		r := []rune(word)[0]
+		switch {
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		default:
+			for _, s := range scriptLanguages {
+				if unicode.Is(s.script, r) {
+					scripts[s.language]++
+					break
+				}
+			}
+		}
+	}
+
+	// Japanese mixes kana with Chinese characters; Chinese has no kana
+	best, bestCount := "", 0
+	switch {
+	case kana > 0:
+		best, bestCount = "ja", kana+han
+	case han > 0:
+		best, bestCount = "zh", han
+	}
+	for _, s := range scriptLanguages {
+		if scripts[s.language] > bestCount {
+			best, bestCount = s.language, scripts[s.language]
+		}
+	}
+	if latin <= bestCount {
+		return best
+	}
+
+	counts := make(map[string]int)
+	for _, word := range words {
+		for language, stopwords := range latinStopwords {
+			for _, stopword := range stopwords {
+				if word == stopword {
+					counts[language]++
+				}
+			}
+		}
+	}
+	best, bestCount = "", 0
+	for _, language := range []string{"en", "es", "fr", "de", "pt", "it"} {
+		if counts[language] > bestCount {
+			best, bestCount = language, counts[language]
+		}
+	}
+	return best
+}
+
+// Crude English sentiment lexicon
+var (
+	positiveWords = map[string]bool{
+		"good": true, "great": true, "excellent": true, "best": true, "better": true, "love": true,
+		"enjoy": true, "happy": true, "benefit": true, "benefits": true, "easy": true, "simple": true,
+		"effective": true, "success": true, "successful": true, "recommend": true, "useful": true,
+		"helpful": true, "exciting": true, "wonderful": true, "fun": true, "improve": true, "improves": true,
+		"strong": true, "clear": true, "elegant": true, "fast": true, "reliable": true, "safe": true,
+	}
+	negativeWords = map[string]bool{
+		"bad": true, "worse": true, "worst": true, "poor": true, "hate": true, "difficult": true,
+		"hard": true, "problem": true, "problems": true, "risk": true, "risks": true, "fail": true,
+		"fails": true, "failure": true, "wrong": true, "slow": true, "dangerous": true, "unsafe": true,
+		"sad": true, "unfortunately": true, "avoid": true, "bug": true, "bugs": true, "error": true,
+		"errors": true, "broken": true, "confusing": true, "weak": true, "costly": true,
+	}
+)
+
+// englishSentiment labels English words by whether positive or negative
+// words from a small lexicon clearly outnumber the others
+func englishSentiment(words []string) string {
+	var score int
+	for _, word := range words {
+		switch {
+		case positiveWords[word]:
+			score++
+		case negativeWords[word]:
+			score--
+		}
+	}
+	// A word or two either way is noise in a long answer
+	threshold := 1 + len(words)/100
+	switch {
+	case score >= threshold:
+		return SentimentPositive
+	case score <= -threshold:
+		return SentimentNegative
+	}
+	return SentimentNeutral
+}
@@ -0,0 +1,121 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+func TestAnalysisWords(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"  \n\t ", 0},
+		{"Hello, world!", 2},
+		{"It's a well-known fact: Go 1.24 is out.", 8},
+		{"Ça va très bien, merci.", 5},
+		{"Привет, как дела?", 3},
+		{"日本語の文章", 6},
+		{"Go言語は速い", 6},
+		{"안녕하세요 세계", 2},
+		{"naïve café", 2},
+	}
+	for _, tt := range tests {
+		if got := len(analysisWords(tt.text)); got != tt.want {
+			t.Errorf("analysisWords(%q) = %d words %q, want %d", tt.text, got, analysisWords(tt.text), tt.want)
+		}
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"The moon is bright tonight and the sea is calm.", "en"},
+		{"La lune est brillante ce soir et la mer est calme.", "fr"},
+		{"La luna es brillante esta noche y el mar está en calma.", "es"},
+		{"Der Mond ist heute Nacht hell und das Meer ist ruhig.", "de"},
+		{"今夜は月が明るく、海は穏やかです。", "ja"},
+		{"今晚月亮很亮，大海很平静。", "zh"},
+		{"오늘 밤은 달이 밝고 바다는 잔잔합니다.", "ko"},
+		{"Сегодня ночью луна яркая, а море спокойное.", "ru"},
+		{"In Go, `context.Context` は必須です。", "ja"},
+		{"12345 !!!", ""},
+		{"Xyzzy plugh", ""},
+	}
+	for _, tt := range tests {
+		if got := detectLanguage(analysisWords(tt.text)); got != tt.want {
+			t.Errorf("detectLanguage(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestAnalyzeResponse(t *testing.T) {
+	tests := []struct {
+		content   string
+		sentiment string
+	}{
+		{"This is a great, simple and reliable approach. I recommend it.", SentimentPositive},
+		{"This is a bad idea: it is slow, costly and the errors are confusing.", SentimentNegative},
+		{"The function returns the length of the slice.", SentimentNeutral},
+	}
+	for _, tt := range tests {
+		resp := AnalyzeResponse(copilot.Response{Model: "m", Content: tt.content})
+		if resp.DetectedLanguage != "en" || resp.Sentiment != tt.sentiment {
+			t.Errorf("AnalyzeResponse(%q) = %s/%s, want en/%s", tt.content, resp.DetectedLanguage, resp.Sentiment, tt.sentiment)
+		}
+	}
+
+	// Sentiment is only judged in English
+	resp := AnalyzeResponse(copilot.Response{Content: "素晴らしい考えです。"})
+	if resp.WordCount != 9 || resp.DetectedLanguage != "ja" || resp.Sentiment != "" {
+		t.Errorf("unexpected analysis of a Japanese answer: %+v", resp)
+	}
+
+	failed := AnalyzeResponse(copilot.Response{Content: "partial answer", Error: errors.New("boom")})
+	if failed.WordCount != 0 || failed.DetectedLanguage != "" {
+		t.Errorf("a failed answer should not be analyzed: %+v", failed)
+	}
+}
+
+func TestExecuteAnalyzeResponses(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a":  {{Content: "The answer is simple and the result is great."}},
+			"model-b":  {{Content: "答えは簡単です。"}},
+			"chairman": {{Content: "Simple."}},
+		},
+	}
+	for _, analyze := range []bool{false, true} {
+		c := NewCouncilWithClient(Config{
+			Models:           []string{"model-a", "model-b"},
+			Aggregator:       "chairman",
+			Timeout:          time.Minute,
+			AnalyzeResponses: analyze,
+		}, copilot.NewMockClient(fixture, false))
+
+		result := c.Execute(context.Background(), "Is it hard?", nil, nil)
+		if result.Error != nil {
+			t.Fatalf("analyze=%v: unexpected error: %v", analyze, result.Error)
+		}
+		a, b := result.ModelResponses[0], result.ModelResponses[1]
+		if !analyze {
+			if a.WordCount != 0 || b.DetectedLanguage != "" {
+				t.Errorf("answers should not be analyzed without AnalyzeResponses: %+v %+v", a, b)
+			}
+			continue
+		}
+		if a.WordCount != 9 || a.DetectedLanguage != "en" || a.Sentiment != SentimentPositive {
+			t.Errorf("unexpected analysis of model-a: %d/%s/%s", a.WordCount, a.DetectedLanguage, a.Sentiment)
+		}
+		if b.WordCount != 7 || b.DetectedLanguage != "ja" {
+			t.Errorf("unexpected analysis of model-b: %d/%s", b.WordCount, b.DetectedLanguage)
+		}
+	}
+}
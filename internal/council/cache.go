@@ -0,0 +1,23 @@
+package council
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// AggregationCache keeps final syntheses between runs, so that a run whose
+// aggregator would see exactly the same input can skip the aggregator call
+type AggregationCache interface {
+	// Load returns the synthesis stored under key, if there is a fresh one
+	Load(key string) (string, bool)
+	// Store saves a synthesis under key
+	Store(key, answer string) error
+}
+
+// aggregationKey identifies an aggregation by the aggregator and its full prompt.
+// The prompt already holds the question, every answer, the peer reviews, the
+// chairman's instructions and the answer length, so any change to them misses.
+func aggregationKey(model, prompt string) string {
+	sum := sha256.Sum256([]byte(model + "\x00" + prompt))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,61 @@
+package council
+
+// Chairman personas set the task instructions of the aggregation prompt
+const (
+	ChairmanDecisive       = "decisive"        // One clear stance, no "it depends"
+	ChairmanBalanced       = "balanced"        // Lays out the viewpoints instead of forcing one answer
+	ChairmanAcademic       = "academic"        // Rigorous, cites evidence and states uncertainty
+	ChairmanDevilsAdvocate = "devils-advocate" // Answers, then argues against the council's consensus
+)
+
+// ChairmanPersonas lists the supported chairman personas, default first
+var ChairmanPersonas = []string{ChairmanDecisive, ChairmanBalanced, ChairmanAcademic, ChairmanDevilsAdvocate}
+
+// chairmanInstructions is each persona's task block in the aggregation prompt
+var chairmanInstructions = map[string]string{
+	ChairmanDecisive: `1. Synthesize the BEST answer to the original question
+2. Take a CLEAR, DECISIVE stance - avoid vague "it depends" answers
+3. If there are multiple valid approaches, CHOOSE the best one and explain why
+4. Provide ACTIONABLE recommendations
+5. Support your decision with the strongest evidence from the responses
+
+The council expects a definitive answer. Be confident in your conclusion.`,
+
+	ChairmanBalanced: `1. Identify the distinct viewpoints the council members put forward
+2. Present each viewpoint fairly, with its strongest supporting arguments
+3. Explain the trade-offs and the situations in which each viewpoint is the better fit
+4. Point out where the members agree, as common ground
+5. Do NOT force a single answer when the question is genuinely open-ended
+
+The council expects a balanced overview that helps the reader decide for themselves.`,
+
+	ChairmanAcademic: `1. Synthesize the most accurate answer to the original question
+2. Ground every claim in the reasoning or evidence given in the responses
+3. Distinguish established facts from interpretation and speculation
+4. State the remaining uncertainty and what would resolve it
+5. Use precise terminology and a structured, rigorous presentation
+
+The council expects a careful, well-supported answer rather than a confident one.`,
+
+	ChairmanDevilsAdvocate: `1. Synthesize the answer the council converges on
+2. Then argue AGAINST it: find the weakest assumptions and the strongest counterarguments
+3. Describe the situations in which the consensus answer would be wrong
+4. Highlight any minority view in the responses that deserves more weight
+5. Conclude whether the consensus survives the challenge, and with what caveats
+
+The council expects its consensus to be stress-tested, not simply restated.`,
+}
+
+// IsChairmanPersona reports whether persona names a supported chairman persona
+func IsChairmanPersona(persona string) bool {
+	_, ok := chairmanInstructions[persona]
+	return ok
+}
+
+// chairmanTask returns the task instructions for a persona, decisive by default
+func chairmanTask(persona string) string {
+	if task, ok := chairmanInstructions[persona]; ok {
+		return task
+	}
+	return chairmanInstructions[ChairmanDecisive]
+}
@@ -0,0 +1,104 @@
+package council
+
+import "sort"
+
+// ConsensusScore is a model's aggregated standing across all peer reviews
+type ConsensusScore struct {
+	Model   string
+	Score   float64 // Average normalized Borda score, 1 = always ranked first
+	Reviews int     // Number of reviews that ranked this model
+}
+
+// computeConsensus aggregates the reviewers' rankings into a Borda-style score.
+// Each review contributes (k-rank)/(k-1) points for a list of k ranked responses,
+// and a model's score is averaged over the reviews that ranked it, so models
+// reviewed a different number of times (see MaxReviewPairs) stay comparable.
+// Reviewers listed in weights have their points (and share of the average)
+// scaled accordingly; all other reviewers weigh 1.
+func computeConsensus(reviews []Review, weights map[string]float64) []ConsensusScore {
+	totals := make(map[string]float64)
+	weightSums := make(map[string]float64)
+	counts := make(map[string]int)
+	order := make([]string, 0)
+
+	for _, review := range reviews {
+		k := len(review.Rankings)
+		if review.Error != nil || k < 2 {
+			continue
+		}
+		weight := reviewerWeight(weights, review.ReviewerModel)
+		if weight <= 0 {
+			continue
+		}
+		for _, ranking := range review.Rankings {
+			if ranking.Model == "" || ranking.Rank < 1 || ranking.Rank > k {
+				continue
+			}
+			if _, seen := counts[ranking.Model]; !seen {
+				order = append(order, ranking.Model)
+			}
+			totals[ranking.Model] += weight * float64(k-ranking.Rank) / float64(k-1)
+			weightSums[ranking.Model] += weight
+			counts[ranking.Model]++
+		}
+	}
+
+	scores := make([]ConsensusScore, 0, len(order))
+	for _, model := range order {
+		scores = append(scores, ConsensusScore{
+			Model:   model,
+			Score:   totals[model] / weightSums[model],
+			Reviews: counts[model],
+		})
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+	return scores
+}
+
+// reviewerWeight returns the configured weight for a reviewer, defaulting to 1
+func reviewerWeight(weights map[string]float64, reviewer string) float64 {
+	if w, ok := weights[reviewer]; ok {
+		return w
+	}
+	return 1
+}
+
+// councilFavorite returns the member ranked first by the most reviewers, and
+// how many put it first. A tie for the most first places has no favorite.
+func councilFavorite(reviews []Review) (string, int) {
+	winners, votes := pluralityWinners(reviews)
+	if len(winners) != 1 {
+		return "", 0
+	}
+	return winners[0], votes
+}
+
+// pluralityWinners returns the members ranked first by the most reviewers,
+// in name order when several tie, and how many reviewers put each of them first
+func pluralityWinners(reviews []Review) ([]string, int) {
+	votes := make(map[string]int)
+	for _, review := range reviews {
+		if review.Error != nil {
+			continue
+		}
+		if top := topRanked(review.Rankings); top != "" {
+			votes[top]++
+		}
+	}
+
+	var winners []string
+	most := 0
+	for model, n := range votes {
+		switch {
+		case n > most:
+			winners, most = []string{model}, n
+		case n == most:
+			winners = append(winners, model)
+		}
+	}
+	sort.Strings(winners)
+	return winners, most
+}
@@ -2,39 +2,283 @@ package council
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/openjny/council/internal/copilot"
 )
 
+// AutoAggregator selects the top-ranked council member as the aggregator
+const AutoAggregator = "auto"
+
+// NoAggregator as the aggregator runs the council in ModeGather
+const NoAggregator = "none"
+
+// ErrMissingModels reports that RequireAllModels was set and some members failed
+var ErrMissingModels = errors.New("required models failed to respond")
+
+// ErrUnrankedReview reports that StrictReviews was set and a review did not
+// rank the responses in the expected format
+var ErrUnrankedReview = errors.New("review has no ranking in the expected format")
+
+// ErrReviewSkipped marks, in its EventFinished, a review cancelled because
+// the ReviewQuorum was already reached
+var ErrReviewSkipped = errors.New("skipped: review quorum reached")
+
+// ErrInsufficientContent reports an answer shorter than MinResponseLength
+var ErrInsufficientContent = errors.New("insufficient content")
+
 // PromptCallback is called when a prompt is sent to a model
 type PromptCallback func(model, prompt, response string)
 
+// PromptTraceFunc is called with each prompt immediately before it is sent
+type PromptTraceFunc func(stage, model, prompt string)
+
 // PhaseCallback is called when a new phase starts
 type PhaseCallback func(phase string, modelCount int)
 
 // Config represents the configuration for the council
 type Config struct {
 	Models     []string
+	Members    []copilot.Member // Council seats; when empty, one plain member per model
 	Aggregator string
 	Timeout    time.Duration
 	Verbose    bool
 	OriginalQ  string
+
+	// PromptPrefix and PromptSuffix wrap the question in the initial stage only
+	PromptPrefix string
+	PromptSuffix string
+
+	// MaxReviewPairs caps the number of (reviewer, reviewed) evaluations; 0 means no cap
+	MaxReviewPairs int
+
+	// ReviewerWeights scales each reviewer's influence on the consensus (default 1)
+	ReviewerWeights map[string]float64
+
+	// MaxReviewers limits peer review to a random subset of this many members; 0 means everyone reviews
+	MaxReviewers int
+
+	// ReviewerSeed seeds the MaxReviewers selection; 0 picks a fresh seed per run
+	ReviewerSeed int64
+
+	// ReviewQuorum stops peer review once this many reviewers agree on the best response; 0 disables
+	ReviewQuorum int
+
+	// TracePrompt, when set, observes every prompt right before it is sent
+	TracePrompt PromptTraceFunc
+
+	// SplitReasoning asks the aggregator to separate its reasoning from the final answer
+	SplitReasoning bool
+
+	// RetryEmpty is how many times a model that returned no content is re-asked
+	RetryEmpty int
+
+	// MinResponseLength fails answers with fewer characters (or words, with
+	// MinResponseWords) than this, so they are left out of review and
+	// aggregation; 0 accepts any non-empty answer
+	MinResponseLength int
+	MinResponseWords  bool
+
+	// MinReviewLength skips peer review when every successful answer has
+	// fewer characters (or words, with MinReviewWords) than this, as there is
+	// little to judge in one-word answers; 0 always reviews
+	MinReviewLength int
+	MinReviewWords  bool
+
+	// DetectRefusals flags answers that decline to help, keeps them from
+	// topping the consensus and tells the chairman about them
+	DetectRefusals bool
+
+	// ExecModels are pseudo-models answered by external commands; list their
+	// names in Models (or Members) to seat them on the council
+	ExecModels []ExecModel
+
+	// LatencyHints holds typical response times by model name. Requests to
+	// the fastest models are launched first; models without a hint follow in
+	// their configured order.
+	LatencyHints map[string]time.Duration
+
+	// MaxConcurrency caps how many members are asked at once in the query
+	// phase, for clients that support it; with LatencyHints the fastest get
+	// the first slots. 0 asks every member at once.
+	MaxConcurrency int
+
+	// ContextWindows holds the context window, in tokens, of models by name.
+	// An aggregation prompt estimated to exceed its aggregator's window is
+	// shrunk before it is sent rather than after the backend rejects it.
+	ContextWindows map[string]int
+
+	// IncludePartial keeps answers that broke off mid-stream in review and
+	// aggregation instead of counting them as failures
+	IncludePartial bool
+
+	// Stream asks members over streaming sessions, for clients that support
+	// it, so time to first token and partial answers are recorded
+	Stream bool
+
+	// RefineRounds feeds the synthesis back to the council this many times for regeneration
+	RefineRounds int
+
+	// CollectDissents asks each member where it disagrees with the final answer
+	CollectDissents bool
+
+	// VerifyQuotes has the aggregator check every factual claim of the final
+	// answer against the members' responses, marking unsupported ones inline
+	VerifyQuotes bool
+
+	// Warmup creates every member's session before the query, so that session
+	// startup is not counted in response durations
+	Warmup bool
+
+	// QueryOnly stops after the members answer, skipping review and aggregation
+	QueryOnly bool
+
+	// Mode selects how members work together (ModeCouncil by default)
+	Mode string
+
+	// ChairmanPersona selects the aggregator's task instructions (ChairmanDecisive by default)
+	ChairmanPersona string
+
+	// Framing selects the wording of the review and synthesis prompts
+	// (FramingCouncil by default); FramingNeutral drops the council and its
+	// Chairman for plain terms
+	Framing string
+
+	// AggregatorContext is guidance (a rubric, a house style) shown only to
+	// the chairman when synthesizing; members and reviewers never see it
+	AggregatorContext string
+
+	// AggregationStrategy selects how the chairman synthesizes the answer
+	// (AggregationSingle by default)
+	AggregationStrategy string
+
+	// CollectSources gathers the URLs, DOIs and arXiv IDs the members cite,
+	// asks the chairman to cite them, and lists them under the final answer
+	CollectSources bool
+
+	// CompareStrategies also computes the final answer with the vote and judge
+	// strategies, for comparison with the chairman's synthesis
+	CompareStrategies bool
+
+	// NoReviewFrom lists members (or models) whose answers are reviewed and
+	// aggregated, but who do not review others
+	NoReviewFrom []string
+
+	// ReviewerMinWords keeps members whose own answer has fewer words than
+	// this, or refused, from reviewing; their answers are still reviewed.
+	// 0 lets every member review.
+	ReviewerMinWords int
+
+	// AnswerLength asks the aggregator for a short, medium or long answer; advisory only
+	AnswerLength string
+
+	// MaxAnswerWords asks for at most this many words and trims the answer at a
+	// sentence boundary if the aggregator overshoots; 0 means no cap
+	MaxAnswerWords int
+
+	// AggregationCache, when set, reuses a stored synthesis instead of asking the
+	// aggregator again for identical input
+	AggregationCache AggregationCache
+
+	// MinDistinct asks more models when the answers hold fewer distinct
+	// viewpoints than this, until they do or DistinctPool runs out; 0 disables
+	MinDistinct int
+
+	// DistinctPool lists the models MinDistinct may add to the council, in
+	// order; when empty, every model the backend offers is a candidate
+	DistinctPool []string
+
+	// ReuseResponses are the members' answers to this question from an
+	// earlier run. The first round uses them instead of querying the members
+	// and goes straight to peer review and aggregation.
+	ReuseResponses []copilot.Response
+
+	// ResponseCache, when set, answers a repeated model call (same member,
+	// same prompt) from the cache and stores every new complete answer
+	ResponseCache ResponseCache
+
+	// Offline never calls a model: every answer must come from ResponseCache,
+	// and a run that needed one it does not hold fails with ErrNotCached,
+	// naming the members that missed. Exec models still run their commands.
+	Offline bool
+
+	// SmartAggregate skips the aggregator when every member gave essentially the same answer
+	SmartAggregate bool
+
+	// OutputSchema, a JSON Schema for an object, has every member answer with
+	// JSON matching it. The answers are then merged field by field by
+	// majority vote instead of by peer review and the chairman; answers that
+	// do not match are left out and listed in Result.NonConforming.
+	OutputSchema string
+
+	// AggregateSingleSuccess still reviews and synthesizes when only one
+	// member of several answered. By default that answer becomes the final
+	// answer as is.
+	AggregateSingleSuccess bool
+
+	// Tiebreaker, when set, is asked to compare the two top responses head
+	// to head when peer review leaves them tied (within 0.05 of each other),
+	// and its pick leads the consensus. No call is made without a tie.
+	Tiebreaker string
+
+	// AnalyzeResponses annotates every answer with its word count, language
+	// and a crude sentiment label (see AnalyzeResponse)
+	AnalyzeResponses bool
+
+	// ModelOptions holds per-model message overrides, keyed by model name
+	ModelOptions map[string]copilot.SendOptions
+
+	// ReviewLabels is the label scheme for anonymized responses (LabelsAlpha by default)
+	ReviewLabels string
+
+	// RevealAuthors labels responses in peer review with their members' names
+	// instead of anonymizing them
+	RevealAuthors bool
+	// StrictReviews counts a review without a ranking that can be parsed as
+	// failed (ErrUnrankedReview) instead of quietly leaving it out of the consensus
+	StrictReviews bool
+
+	// RequireAllModels fails the run if any member fails, instead of continuing best-effort
+	RequireAllModels bool
+
+	// History holds prior turns or few-shot examples replayed before the question
+	History []Message
+}
+
+// Message roles in conversation history
+const (
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// Message is one prior conversation turn
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
 }
 
 // Review represents a model's review of other responses
 type Review struct {
-	ReviewerModel string
+	ReviewerModel string // Member label of the reviewer
 	Rankings      []Ranking
 	Duration      time.Duration
 	Error         error
+	Skipped       bool     // Cancelled because the review quorum was already reached
+	Malformed     []string // Ranking lines rejected as repeated or extra, described
 }
 
 // Ranking represents a model's ranking of an anonymized response
 type Ranking struct {
 	ResponseIndex int    // Index of the response being ranked
+	Model         string // Member label of the ranked response
 	Rank          int    // 1 = best, higher = worse
 	Reasoning     string // Why this rank was given
 }
@@ -42,33 +286,136 @@ type Ranking struct {
 // Result represents the final result from the council
 type Result struct {
 	ModelResponses      []copilot.Response
+	InitialResponses    []copilot.Response
 	Reviews             []Review
 	AggregatedResponse  string
 	AggregationDuration time.Duration
 	ReviewDuration      time.Duration
 	InitialPrompt       string // The question asked to models
-	ReviewPrompts       map[string]string // Model -> review prompt
+	ReviewPrompts       map[string]string // Member -> review prompt
 	AggregationPrompt   string // Final aggregation prompt
+	ReviewPairs         int               // Number of (reviewer, reviewed) evaluations requested
+	ReviewCoverage      map[string]int    // Member -> number of reviewers that evaluated it
+	Reviewers           []string          // Members that acted as reviewers
+	Abstained           []string          // Members excluded from reviewing by NoReviewFrom
+	GatedReviewers      []string          // Members kept from reviewing by ReviewerMinWords
+	Reductions          []string          // What was dropped from the aggregation prompt to fit the context window
+	GroupSummaries      []GroupSummary    // Chairman's summaries of groups of responses, with AggregationMapReduce
+	PromptTokens        int               // Estimated size of the aggregation prompt sent, when ContextWindow is known
+	ContextWindow       int               // The aggregator's window from Config.ContextWindows; 0 if unknown
+	Strategies          []Aggregation     // Final answer per strategy, with CompareStrategies
+	Consensus           []ConsensusScore  // Peer review consensus, best first
+	EchoedModel         string            // Member whose response the final answer closely matches, if any
+	EchoSimilarity      float64           // Similarity between the final answer and the closest response
+	AggregatorModel     string            // Model that produced the final synthesis, or the member answering for a skipped one
+	SkippedReviews      int               // Reviews cancelled because the quorum was reached
+	AggregatorReasoning string            // Chairman's reasoning when SplitReasoning is enabled
+	AggregationSkipped  string            // Why aggregation was skipped (a Skip* reason), if it was
+	ReviewSkipped       string            // Why peer review was skipped under MinReviewLength, if it was
+	ReplacedAggregator  string            // Configured aggregator replaced because it failed as a member
+	Rounds              []Result          // Every pass when RefineRounds is set, first draft first
+	SubResults          []Result          // One result per question of ExecuteMulti, in order
+	Dissents            []Dissent         // Members' disagreements with the final answer
+	ClaimChecks         []ClaimCheck      // Verdicts on the final answer's claims, with VerifyQuotes
+	FieldVotes          []FieldVote       // The vote on each field, with OutputSchema
+	NonConforming       []SchemaViolation // Answers that did not match the OutputSchema
+	VerifyError         error             // Checking the claims failed; the answer is unmarked
+	WarmupDuration      time.Duration     // Time spent creating sessions up front, with Config.Warmup
+	Revisions           int               // Roundtable revisions that succeeded; InitialResponses keeps the first answers
+	AnswerLength        string            // Requested answer length, e.g. "short, max 150 words"
+	AnswerTrimmed       bool              // Answer was cut to MaxAnswerWords
+	AggregationCached   bool              // Synthesis came from the AggregationCache
+	CacheError          error             // Storing the synthesis in the AggregationCache failed
+	AggregationRetries  int               // Times the aggregator was asked again with a reduced prompt
+	Favorite            string            // Member ranked first by the most reviewers; empty on a tie
+	FavoriteVotes       int               // Reviewers that ranked Favorite first
+	PluralityWinner     []string          // Members ranked first by the most reviewers; several on a tie
+	PluralityVotes      int               // Reviewers that ranked each PluralityWinner first
+	ClosestToAnswer     string            // Member whose answer the final answer resembles most
+	Sources             []string          // Sources cited across the council, with CollectSources
+	DistinctViewpoints  int               // Clusters of similar answers, with MinDistinct
+	ResponsesReused     bool              // Stage 1 answers came from Config.ReuseResponses
+	AddedMembers        []string          // Models MinDistinct added to the council
+	Tiebreak            *Tiebreak         // The Tiebreaker's decision, when the top two were tied
 	Error               error
+
+	// With ModeProposer, the critics' responses to the proposal and how long they took
+	Critiques        []copilot.Response
+	CritiqueDuration time.Duration
+}
+
+// ModelClient is the model backend the council talks to.
+// *copilot.Client implements it against the Copilot CLI; *copilot.MockClient
+// implements it offline for demos and tests.
+type ModelClient interface {
+	AskMultipleModels(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, progress copilot.ProgressCallback) []copilot.Response
+	AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error)
+	Close() error
+}
+
+// responseAsker is a ModelClient that hands over each response as soon as
+// it completes, as *copilot.Client and *copilot.MockClient do
+type responseAsker interface {
+	AskMultipleModelsWithResponses(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response
+}
+
+// askWithResponses asks members through client, passing each response to
+// onResponse as it completes. Clients that only report progress hand the
+// responses over once all are in.
+func askWithResponses(ctx context.Context, client ModelClient, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response {
+	if asker, ok := client.(responseAsker); ok {
+		return asker.AskMultipleModelsWithResponses(ctx, members, question, timeout, onResponse)
+	}
+	responses := client.AskMultipleModels(ctx, members, question, timeout, nil)
+	if onResponse != nil {
+		for _, resp := range responses {
+			onResponse(resp)
+		}
+	}
+	return responses
 }
 
 // Council orchestrates multiple AI models and aggregates their responses
 type Council struct {
-	client *copilot.Client
+	client ModelClient
 	config Config
+	skip   skipSwitch // Cuts the current query phase short; see SkipPending
 }
 
-// NewCouncil creates a new council instance
+// NewCouncil creates a new council instance. Offline councils never start
+// the Copilot client.
 func NewCouncil(config Config) (*Council, error) {
+	if config.Offline {
+		return NewCouncilWithClient(config, nil), nil
+	}
 	client, err := copilot.NewClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Copilot client: %w", err)
 	}
 
+	return NewCouncilWithClient(config, client), nil
+}
+
+// NewCouncilWithClient creates a council backed by the given model client
+// With Config.Offline the client is never called.
+func NewCouncilWithClient(config Config, client ModelClient) *Council {
+	if config.Offline {
+		client = &offlineClient{}
+	}
+	if capped, ok := client.(interface{ SetMaxConcurrency(int) }); ok && config.MaxConcurrency > 0 {
+		capped.SetMaxConcurrency(config.MaxConcurrency)
+	}
+	if streamer, ok := client.(interface{ SetStreaming(bool) }); ok && config.Stream {
+		streamer.SetStreaming(true)
+	}
+	client = withExecModels(client, config.ExecModels)
+	if config.ResponseCache != nil {
+		client = &cachingClient{ModelClient: client, cache: config.ResponseCache}
+	}
 	return &Council{
 		client: client,
 		config: config,
-	}, nil
+	}
 }
 
 // Close releases resources
@@ -79,27 +426,187 @@ func (c *Council) Close() error {
 	return nil
 }
 
-// Execute runs the council pattern: ask multiple models, then aggregate
+// SessionsDestroyed reports how many model sessions were cleaned up without
+// error and how many failed to be, for clients that track it
+func (c *Council) SessionsDestroyed() (destroyed, failed int, ok bool) {
+	counter, ok := c.backend().(interface{ SessionsDestroyed() (int, int) })
+	if !ok {
+		return 0, 0, false
+	}
+	destroyed, failed = counter.SessionsDestroyed()
+	return destroyed, failed, true
+}
+
+// Execute runs the council pattern: ask multiple models, then aggregate.
+// progressCallback and phaseCallback are fed from the event stream; see
+// ExecuteWithEvents for the full set of events.
 func (c *Council) Execute(ctx context.Context, question string, progressCallback copilot.ProgressCallback, phaseCallback PhaseCallback) Result {
+	return c.ExecuteWithEvents(ctx, question, progressEvents(progressCallback, phaseCallback))
+}
+
+// ExecuteWithEvents runs the council pattern, reporting its progress to handler.
+// Events from parallel requests are delivered concurrently, so handler must be
+// safe for concurrent use. A nil handler is allowed.
+func (c *Council) ExecuteWithEvents(ctx context.Context, question string, handler EventHandler) (final Result) {
+	emit := timestamped(handler)
+
+	// Offline, name every member whose answer was not in the cache
+	if offline, ok := c.backend().(*offlineClient); ok {
+		offline.takeMissing()
+		defer func() {
+			if missing := offline.takeMissing(); len(missing) > 0 {
+				final.Error = fmt.Errorf("offline: %w for %s", ErrNotCached, strings.Join(missing, ", "))
+			}
+		}()
+	}
+
+	// Report when the client slows down because of rate limiting
+	if throttled, ok := c.backend().(interface{ OnThrottle(copilot.ThrottleHandler) }); ok {
+		throttled.OnThrottle(func(limit int, backoff time.Duration) {
+			emit(Event{Type: EventThrottled, Count: limit, Duration: backoff})
+		})
+	}
+
+	var warmup time.Duration
+	if c.config.Warmup {
+		warmup = c.warmup(ctx, emit)
+	}
+
+	final = c.executeRound(ctx, question, "", emit)
+	final.WarmupDuration = warmup
+	if final.Error != nil || c.config.QueryOnly || c.gathers() {
+		return final
+	}
+
+	// Feed the synthesis back to the council until the rounds run out
+	if c.config.RefineRounds > 0 {
+		rounds := []Result{final}
+		for round := 1; round <= c.config.RefineRounds && ctx.Err() == nil; round++ {
+			emit(Event{Type: EventPhaseStart, Phase: PhaseRefine, Count: round})
+			next := c.executeRound(ctx, question, final.AggregatedResponse, emit)
+			rounds = append(rounds, next)
+			if next.Error != nil {
+				break
+			}
+			final = next
+		}
+		final.Rounds = rounds
+		final.WarmupDuration = warmup
+	}
+
+	if c.config.CompareStrategies {
+		final.Strategies = c.compareStrategies(ctx, question, final)
+	}
+
+	if c.config.VerifyQuotes {
+		final.AggregatedResponse, final.ClaimChecks, final.VerifyError = c.verifyClaims(ctx, question, final, emit)
+	}
+
+	if c.config.CollectDissents {
+		emit(Event{Type: EventPhaseStart, Phase: PhaseDissent, Count: len(final.ModelResponses)})
+		final.Dissents = c.collectDissents(ctx, question, final.AggregatedResponse, final.ModelResponses)
+	}
+	return final
+}
+
+// warmup pre-creates the members' sessions on clients that support it and
+// returns how long that took. Failures are not fatal: those members create
+// their session when asked, and the query reports the error.
+func (c *Council) warmup(ctx context.Context, emit EventHandler) time.Duration {
+	warmer, ok := c.backend().(interface {
+		Warmup(ctx context.Context, members []copilot.Member, timeout time.Duration) error
+	})
+	if !ok {
+		return 0
+	}
+
+	// Exec models have no session to create
+	var members []copilot.Member
+	for _, member := range c.members() {
+		if !c.isExecModel(member.Model) {
+			members = append(members, member)
+		}
+	}
+	emit(Event{Type: EventPhaseStart, Phase: PhaseWarmup, Count: len(members)})
+	start := time.Now()
+	_ = warmer.Warmup(ctx, members, c.config.Timeout)
+	return time.Since(start)
+}
+
+// executeRound runs one full council pass. With a draft, members are asked to
+// improve it instead of answering the question from scratch.
+func (c *Council) executeRound(ctx context.Context, question, draft string, emit EventHandler) Result {
+	initialPrompt := c.buildInitialPrompt(question)
+	if draft != "" {
+		initialPrompt = buildRefinePrompt(initialPrompt, draft)
+	}
 	result := Result{
-		InitialPrompt: question,
+		InitialPrompt: initialPrompt,
 		ReviewPrompts: make(map[string]string),
 	}
 
-	// Step 1: Ask all models in parallel
-	result.ModelResponses = c.client.AskMultipleModels(
-		ctx,
-		c.config.Models,
-		question,
-		c.config.Timeout,
-		progressCallback,
-	)
+	// Step 1: Ask all members in parallel. In proposer mode only the first
+	// member answers; the others critique its proposal in step 2.
+	members := c.members()
+	var critics []copilot.Member
+	if c.config.Mode == ModeProposer && len(members) > 1 {
+		members, critics = members[:1], members[1:]
+	}
+	names := make([]string, len(members))
+	for i, member := range members {
+		names[i] = member.Name
+	}
+	emit(Event{Type: EventPhaseStart, Phase: PhaseQuery, Count: len(members), Prompt: initialPrompt})
+	if draft == "" && len(c.config.ReuseResponses) > 0 {
+		// Answers from an earlier run stand in for the query
+		result.ModelResponses = append([]copilot.Response(nil), c.config.ReuseResponses...)
+		result.ResponsesReused = true
+		for _, resp := range result.ModelResponses {
+			emit(Event{Type: EventStarted, Phase: PhaseQuery, Member: resp.Label(), Model: resp.Model})
+			emit(Event{Type: EventFinished, Phase: PhaseQuery, Member: resp.Label(), Model: resp.Model, Content: resp.Content, Duration: resp.Duration, Err: resp.Error})
+		}
+	} else {
+		for _, i := range latencyOrder(members, c.config.LatencyHints) {
+			emit(Event{Type: EventStarted, Phase: PhaseQuery, Member: members[i].Name, Model: members[i].Model})
+		}
+		c.tracePrompt(PhaseQuery, strings.Join(names, ", "), initialPrompt)
+		result.ModelResponses = c.askInLatencyOrder(
+			c.skip.arm(ctx),
+			members,
+			initialPrompt,
+			func(resp copilot.Response) {
+				c.skip.markSkipped(&resp)
+				emit(Event{Type: EventFinished, Phase: PhaseQuery, Member: resp.Label(), Model: resp.Model, Content: resp.Content, Duration: resp.Duration, TTFT: resp.TimeToFirstToken, Err: resp.Error})
+			},
+		)
+		c.skip.disarm()
+		for i := range result.ModelResponses {
+			c.skip.markSkipped(&result.ModelResponses[i])
+		}
+		c.handleEmptyResponses(ctx, initialPrompt, result.ModelResponses, emit)
+	}
+	if c.config.IncludePartial {
+		acceptPartialResponses(result.ModelResponses)
+	}
+	markInsufficient(result.ModelResponses, c.config.MinResponseLength, c.config.MinResponseWords)
+	if c.config.DetectRefusals {
+		markRefusals(result.ModelResponses)
+	}
+	if c.config.MinDistinct > 0 && c.config.Mode != ModeProposer {
+		c.ensureDistinct(ctx, initialPrompt, &result, emit)
+	}
+	if c.config.AnalyzeResponses {
+		analyzeResponses(result.ModelResponses)
+	}
 
 	// Check if we got at least one successful response
 	successCount := 0
+	var failed []string
 	for _, resp := range result.ModelResponses {
 		if resp.Error == nil && resp.Content != "" {
 			successCount++
+		} else {
+			failed = append(failed, resp.Label())
 		}
 	}
 
@@ -107,41 +614,478 @@ func (c *Council) Execute(ctx context.Context, question string, progressCallback
 		result.Error = fmt.Errorf("all models failed to respond")
 		return result
 	}
+	if c.config.RequireAllModels && len(failed) > 0 {
+		result.Error = fmt.Errorf("%w: %s", ErrMissingModels, strings.Join(failed, ", "))
+		return result
+	}
+	if c.config.QueryOnly {
+		return result
+	}
 
-	// Step 2: Conduct peer review (each model reviews others' responses)
-	if phaseCallback != nil {
-		phaseCallback("review", successCount)
+	// Structured answers are merged by a vote on each field, not by the chairman
+	if c.config.OutputSchema != "" {
+		c.voteOnFields(&result)
+		return result
+	}
+
+	// When the others failed, the lone answer has nobody to review it, and a
+	// synthesis would only rewrite it
+	if successCount == 1 && len(failed) > 0 && !c.config.AggregateSingleSuccess && c.config.Mode != ModeProposer && !c.gathers() {
+		if c.config.CollectSources {
+			result.Sources = collectCitations(result.ModelResponses)
+		}
+		for _, resp := range result.ModelResponses {
+			if resp.Error == nil && resp.Content != "" {
+				c.answerWith(&result, resp, SkipSingleSuccess)
+			}
+		}
+		return result
+	}
+
+	switch c.config.Mode {
+	case ModeRoundtable:
+		// Step 2: Members revise their answers after reading each other's
+		result.InitialResponses = result.ModelResponses
+		result.ModelResponses, result.Revisions = c.roundtable(ctx, question, result.ModelResponses, emit)
+		if c.config.AnalyzeResponses {
+			analyzeResponses(result.ModelResponses)
+		}
+	case ModeProposer:
+		// Step 2: The other members critique the proposal
+		c.executeProposal(ctx, question, critics, &result, emit)
+	default:
+		// Step 2: Conduct peer review (each model reviews others' responses),
+		// unless there is nothing to review in answers that are all very short
+		if successCount >= 2 {
+			result.ReviewSkipped = tooShortToReview(result.ModelResponses, c.config.MinReviewLength, c.config.MinReviewWords)
+		}
+		if result.ReviewSkipped == "" {
+			emit(Event{Type: EventPhaseStart, Phase: PhaseReview, Count: successCount})
+
+			reviewStart := time.Now()
+			result.Reviews = c.conductPeerReview(ctx, question, result.ModelResponses, emit, &result)
+			result.ReviewDuration = time.Since(reviewStart)
+		}
+		result.Consensus = computeConsensus(result.Reviews, c.config.ReviewerWeights)
+		demoteRefusals(result.Consensus, result.ModelResponses)
+		if c.config.Tiebreaker != "" {
+			c.breakTie(ctx, question, &result, emit)
+		}
+		result.Favorite, result.FavoriteVotes = councilFavorite(result.Reviews)
+		result.PluralityWinner, result.PluralityVotes = pluralityWinners(result.Reviews)
+	}
+	if c.config.CollectSources {
+		result.Sources = collectCitations(result.ModelResponses)
+	}
+
+	// Gather mode hands the answers and their ranking over without a synthesis
+	if c.gathers() {
+		return result
+	}
+
+	// A unanimous council needs no chairman
+	if c.config.SmartAggregate {
+		if answer, ok := unanimousAnswer(result.ModelResponses, result.Consensus); ok {
+			c.answerWith(&result, answer, SkipUnanimous)
+			return result
+		}
 	}
-	
-	reviewStart := time.Now()
-	result.Reviews = c.conductPeerReview(ctx, question, result.ModelResponses, progressCallback, &result)
-	result.ReviewDuration = time.Since(reviewStart)
 
 	// Step 3: Build aggregation prompt with review results
 	aggregationPrompt := c.buildAggregationPrompt(question, result.ModelResponses, result.Reviews)
+	if c.config.Mode == ModeProposer {
+		aggregationPrompt = c.buildProposalAggregationPrompt(question, result.ModelResponses[0], result.Critiques)
+	}
 	result.AggregationPrompt = aggregationPrompt
 
 	// Step 4: Ask aggregator model
-	aggregated, duration, err := c.client.AskSingleModel(
-		ctx,
-		c.config.Aggregator,
-		aggregationPrompt,
-		c.config.Timeout,
-	)
-	if err != nil {
-		result.Error = fmt.Errorf("aggregation failed: %w", err)
-		return result
+	result.AggregatorModel = c.resolveAggregator(result)
+	if failedAsMember(result.ModelResponses, result.AggregatorModel) {
+		result.ReplacedAggregator = result.AggregatorModel
+		result.AggregatorModel = c.leadingMember(result)
 	}
 
-	result.AggregatedResponse = aggregated
+	// The cache is keyed by the prompt the aggregator will be sent, once it
+	// fits the context window. Map-reduce summaries are made from the
+	// prompt's answers, so there the key is the unsummarized prompt and a hit
+	// skips the map phase too.
+	shrinker := c.newPromptShrinker(question, &result)
+	mapReduce := c.mapReduces(result)
+	var key string
+	if mapReduce {
+		key = aggregationKey(result.AggregatorModel, AggregationMapReduce+"\x00"+result.AggregationPrompt)
+	} else {
+		shrinker.fitWindow()
+		key = aggregationKey(result.AggregatorModel, result.AggregationPrompt)
+	}
+	var aggregated string
+	var duration time.Duration
+	if c.config.AggregationCache != nil {
+		aggregated, result.AggregationCached = c.config.AggregationCache.Load(key)
+	}
+	if !result.AggregationCached {
+		// Map-reduce: large councils are summarized in groups first, and the
+		// chairman synthesizes the summaries
+		if mapReduce {
+			result.GroupSummaries = c.summarizeGroups(ctx, question, result, emit)
+			result.AggregationPrompt = c.buildReducePrompt(question, answeredResponses(result.ModelResponses), result.GroupSummaries)
+			shrinker.fitWindow()
+		}
+		emit(Event{Type: EventPhaseStart, Phase: PhaseAggregation, Count: 1})
+		var err error
+		aggregated, duration, err = c.aggregate(ctx, question, &result, shrinker, emit)
+		if err != nil {
+			result.Error = fmt.Errorf("aggregation failed: %w", err)
+			return result
+		}
+		if c.config.AggregationCache != nil {
+			result.CacheError = c.config.AggregationCache.Store(key, aggregated)
+		}
+	}
+
+	if c.config.SplitReasoning {
+		result.AggregatorReasoning, aggregated = splitAggregatorOutput(aggregated)
+	}
+	result.AnswerLength = describeLength(c.config.AnswerLength, c.config.MaxAnswerWords)
+	aggregated, result.AnswerTrimmed = trimToWords(aggregated, c.config.MaxAnswerWords)
+	result.AggregatedResponse = appendSources(aggregated, result.Sources)
 	result.AggregationDuration = duration
+	result.EchoedModel, result.EchoSimilarity = detectEcho(aggregated, result.ModelResponses)
+	result.ClosestToAnswer, _ = closestResponse(aggregated, result.ModelResponses)
 	return result
 }
 
+// Reasons for Result.AggregationSkipped; printers translate them as message keys
+const (
+	SkipSingleSuccess = "single-model fallback"
+	SkipUnanimous     = "unanimous council — aggregation skipped"
+)
+
+// answerWith makes a member's answer the final answer without a synthesis,
+// recording why the aggregator was skipped
+func (c *Council) answerWith(result *Result, answer copilot.Response, reason string) {
+	result.AggregationSkipped = reason
+	result.AggregatorModel = answer.Label()
+	result.AnswerLength = describeLength(c.config.AnswerLength, c.config.MaxAnswerWords)
+	result.AggregatedResponse, result.AnswerTrimmed = trimToWords(answer.Content, c.config.MaxAnswerWords)
+	result.ClosestToAnswer, _ = closestResponse(result.AggregatedResponse, result.ModelResponses)
+	result.AggregatedResponse = appendSources(result.AggregatedResponse, result.Sources)
+}
+
+// maxContextReductions caps how many times a too-long aggregation prompt is shrunk
+const maxContextReductions = 3
+
+// aggregate asks the aggregator for the synthesis. If the prompt exceeds the
+// aggregator's context window, it retries with a prompt made smaller by
+// shrinker: first without the peer review details, then without the
+// lowest-ranked responses. What was dropped is recorded in result.Reductions.
+func (c *Council) aggregate(ctx context.Context, question string, result *Result, shrinker *promptShrinker, emit EventHandler) (string, time.Duration, error) {
+	var total time.Duration
+	for attempt := 0; ; attempt++ {
+		emit(Event{Type: EventStarted, Phase: PhaseAggregation, Member: result.AggregatorModel, Model: result.AggregatorModel, Attempt: attempt})
+		c.tracePrompt(PhaseAggregation, result.AggregatorModel, result.AggregationPrompt)
+		aggregated, duration, err := c.client.AskSingleModel(
+			ctx,
+			c.modelMember(result.AggregatorModel),
+			result.AggregationPrompt,
+			c.config.Timeout,
+		)
+		total += duration
+		emit(Event{Type: EventFinished, Phase: PhaseAggregation, Member: result.AggregatorModel, Model: result.AggregatorModel, Attempt: attempt, Duration: duration, Err: err})
+		if !errors.Is(err, copilot.ErrContextLength) || attempt == maxContextReductions {
+			return aggregated, total, err
+		}
+		result.AggregationRetries++
+
+		// Shrink the prompt and try again
+		if !shrinker.shrink() {
+			return aggregated, total, err
+		}
+		if result.ContextWindow > 0 {
+			result.PromptTokens = estimateTokens(result.AggregationPrompt)
+		}
+	}
+}
+
+// promptShrinker rebuilds the aggregation prompt of a result with less in it
+type promptShrinker struct {
+	c         *Council
+	question  string
+	result    *Result
+	responses []copilot.Response
+	reviews   []Review
+}
+
+// newPromptShrinker starts from the full responses and reviews of result
+func (c *Council) newPromptShrinker(question string, result *Result) *promptShrinker {
+	return &promptShrinker{c: c, question: question, result: result, responses: result.ModelResponses, reviews: result.Reviews}
+}
+
+// shrink drops the next part of the prompt, reporting false when nothing is
+// left to drop
+func (s *promptShrinker) shrink() bool {
+	switch {
+	case len(s.result.GroupSummaries) > 0:
+		// Group summaries are already as small as the prompt gets
+		return false
+	case len(s.reviews) > 0:
+		s.reviews = nil
+		s.result.Reductions = append(s.result.Reductions, "peer review details")
+	case len(s.responses) > 1:
+		var dropped copilot.Response
+		s.responses, dropped = dropLowestRanked(s.responses, s.result.Consensus)
+		s.result.Reductions = append(s.result.Reductions, "response from "+dropped.Label())
+	default:
+		return false
+	}
+	s.result.AggregationPrompt = s.c.buildAggregationPrompt(s.question, s.responses, s.reviews)
+	return true
+}
+
+// fitWindow shrinks the prompt before it is sent until it is estimated to fit
+// the aggregator's context window, when ContextWindows knows it
+func (s *promptShrinker) fitWindow() {
+	window := s.c.config.ContextWindows[s.result.AggregatorModel]
+	if window <= 0 {
+		return
+	}
+	for estimateTokens(s.result.AggregationPrompt) > window {
+		if !s.shrink() {
+			break
+		}
+	}
+	s.result.ContextWindow = window
+	s.result.PromptTokens = estimateTokens(s.result.AggregationPrompt)
+}
+
+// dropLowestRanked removes the response least worth keeping: a failed or
+// unranked one if any, otherwise the one ranked last by consensus
+func dropLowestRanked(responses []copilot.Response, consensus []ConsensusScore) ([]copilot.Response, copilot.Response) {
+	rank := make(map[string]int, len(consensus))
+	for i, score := range consensus {
+		rank[score.Model] = i
+	}
+
+	drop := len(responses) - 1
+	worst := -1
+	for i, resp := range responses {
+		r, ranked := rank[resp.Label()]
+		if resp.Error != nil || !ranked {
+			drop = i
+			break
+		}
+		if r > worst {
+			drop, worst = i, r
+		}
+	}
+
+	kept := make([]copilot.Response, 0, len(responses)-1)
+	kept = append(kept, responses[:drop]...)
+	kept = append(kept, responses[drop+1:]...)
+	return kept, responses[drop]
+}
+
+// handleEmptyResponses re-asks models that answered without error but with no
+// content, up to RetryEmpty times, and marks those still empty as failed
+func (c *Council) handleEmptyResponses(ctx context.Context, prompt string, responses []copilot.Response, emit EventHandler) {
+	reask := fmt.Sprintf("Please provide your answer to: %s", prompt)
+
+	for i := range responses {
+		resp := &responses[i]
+		if resp.Error != nil || resp.Content != "" {
+			continue
+		}
+
+		for attempt := 0; attempt < c.config.RetryEmpty && resp.Content == ""; attempt++ {
+			emit(Event{Type: EventStarted, Phase: PhaseQuery, Member: resp.Label(), Model: resp.Model, Attempt: attempt + 1})
+			c.tracePrompt(PhaseQuery, resp.Label(), reask)
+			content, duration, err := c.client.AskSingleModel(ctx, c.member(resp.Label()), reask, c.config.Timeout)
+			resp.Duration += duration
+			resp.Retries++
+			emit(Event{Type: EventFinished, Phase: PhaseQuery, Member: resp.Label(), Model: resp.Model, Attempt: attempt + 1, Content: content, Duration: duration, Err: err})
+			if err != nil {
+				resp.Error = err
+				break
+			}
+			resp.Content = content
+		}
+
+		if resp.Error == nil && resp.Content == "" {
+			resp.Error = copilot.ErrEmptyResponse
+		}
+	}
+}
+
+// acceptPartialResponses clears the error of answers that broke off after
+// some content arrived, so they are reviewed and aggregated like the rest.
+// Partial stays set so that prompts and output can flag them.
+func acceptPartialResponses(responses []copilot.Response) {
+	for i := range responses {
+		if responses[i].Partial && responses[i].Content != "" {
+			responses[i].Error = nil
+		}
+	}
+}
+
+// partialNote flags an incomplete answer inside review and aggregation prompts
+const partialNote = "(This answer was cut off before it finished.)\n"
+
+// members returns the council seats, deriving plain members from Models when
+// no personas are configured
+func (c *Council) members() []copilot.Member {
+	members := c.config.Members
+	if len(members) == 0 {
+		members = copilot.ModelMembers(c.config.Models)
+	}
+	if len(c.config.ModelOptions) == 0 {
+		return members
+	}
+
+	withOptions := make([]copilot.Member, len(members))
+	for i, member := range members {
+		withOptions[i] = c.withOptions(member)
+	}
+	return withOptions
+}
+
+// member looks up a council seat by its label
+func (c *Council) member(name string) copilot.Member {
+	for _, member := range c.members() {
+		if member.Name == name {
+			return member
+		}
+	}
+	return c.modelMember(name)
+}
+
+// modelMember returns a plain member for model, such as the aggregator
+func (c *Council) modelMember(model string) copilot.Member {
+	return c.withOptions(copilot.ModelMember(model))
+}
+
+// withOptions applies the configured message overrides for the member's model
+func (c *Council) withOptions(member copilot.Member) copilot.Member {
+	if options, ok := c.config.ModelOptions[member.Model]; ok {
+		member.Options = options
+	}
+	return member
+}
+
+// tracePrompt reports a prompt to the configured tracer, if any
+func (c *Council) tracePrompt(stage, model, prompt string) {
+	if c.config.TracePrompt != nil {
+		c.config.TracePrompt(stage, model, prompt)
+	}
+}
+
+// gathers reports whether the run stops before aggregation, leaving
+// AggregatedResponse empty
+func (c *Council) gathers() bool {
+	return c.config.Mode == ModeGather || c.config.Aggregator == NoAggregator
+}
+
+// resolveAggregator returns the model that should synthesize the final answer.
+// With AutoAggregator it picks the consensus leader's model, falling back to
+// the first successful member when peer review produced no usable rankings.
+func (c *Council) resolveAggregator(result Result) string {
+	if c.config.Aggregator != AutoAggregator {
+		return c.config.Aggregator
+	}
+	return c.leadingMember(result)
+}
+
+// failedAsMember reports whether model sat on the council and failed every
+// time it was asked in stage 1
+func failedAsMember(responses []copilot.Response, model string) bool {
+	failed := false
+	for _, resp := range responses {
+		if resp.Model != model {
+			continue
+		}
+		if resp.Error == nil && resp.Content != "" {
+			return false
+		}
+		failed = true
+	}
+	return failed
+}
+
+// leadingMember returns the consensus leader's model, or else the first
+// successful member's
+func (c *Council) leadingMember(result Result) string {
+	if len(result.Consensus) > 0 {
+		return c.member(result.Consensus[0].Model).Model
+	}
+	for _, resp := range result.ModelResponses {
+		if resp.Error == nil && resp.Content != "" {
+			return resp.Model
+		}
+	}
+	return DefaultAggregator()
+}
+
+// buildInitialPrompt wraps the question with the configured prefix and suffix,
+// preceded by the conversation history when there is one and followed by
+// the OutputSchema instruction
+func (c *Council) buildInitialPrompt(question string) string {
+	parts := make([]string, 0, 5)
+	if len(c.config.History) > 0 {
+		parts = append(parts, formatHistory(c.config.History))
+	}
+	if c.config.PromptPrefix != "" {
+		parts = append(parts, c.config.PromptPrefix)
+	}
+	parts = append(parts, question)
+	if c.config.PromptSuffix != "" {
+		parts = append(parts, c.config.PromptSuffix)
+	}
+	if c.config.OutputSchema != "" {
+		parts = append(parts, schemaInstruction(c.config.OutputSchema))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// MaxRefineRounds caps RefineRounds; every round costs a full council run
+const MaxRefineRounds = 3
+
+// buildRefinePrompt asks a member to improve the council's draft answer
+func buildRefinePrompt(prompt, draft string) string {
+	return fmt.Sprintf(`%s
+
+Here's the council's draft answer:
+
+%s
+
+Improve it: fix anything wrong, fill in what is missing, and cut what does not help. Reply with the complete improved answer.`, prompt, draft)
+}
+
+// formatHistory renders prior turns as a transcript. The Copilot SDK sends a
+// single prompt per message, so history is replayed inside the prompt itself.
+func formatHistory(history []Message) string {
+	var sb strings.Builder
+	sb.WriteString("Here is the conversation so far, including examples of the expected answers:\n")
+	for _, msg := range history {
+		speaker := "User"
+		if msg.Role == RoleAssistant {
+			speaker = "Assistant"
+		}
+		sb.WriteString(fmt.Sprintf("\n%s: %s\n", speaker, strings.TrimSpace(msg.Content)))
+	}
+	sb.WriteString("\nContinue the conversation by answering the next message.")
+	return sb.String()
+}
+
+// recoverPanic, deferred in a goroutine that asks a model, turns a panic into
+// a PanicError passed to fail so that it fails that request only instead of
+// crashing the process
+func recoverPanic(fail func(err error)) {
+	if r := recover(); r != nil {
+		fail(copilot.NewPanicError(r))
+	}
+}
+
 // conductPeerReview asks each model to review and rank other models' responses
-func (c *Council) conductPeerReview(ctx context.Context, question string, responses []copilot.Response, progressCallback copilot.ProgressCallback, result *Result) []Review {
-	reviews := make([]Review, 0, len(responses))
-	
+func (c *Council) conductPeerReview(ctx context.Context, question string, responses []copilot.Response, emit EventHandler, result *Result) []Review {
 	// Only review successful responses
 	successfulResponses := make([]copilot.Response, 0)
 	for _, resp := range responses {
@@ -152,83 +1096,308 @@ func (c *Council) conductPeerReview(ctx context.Context, question string, respon
 	
 	// If we have less than 2 successful responses, skip peer review
 	if len(successfulResponses) < 2 {
-		return reviews
+		return []Review{}
 	}
 	
-	// Each model reviews all OTHER responses
+	// Each selected reviewer reviews the OTHER responses assigned to it
+	seed := c.config.ReviewerSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	// Members excluded with NoReviewFrom are still reviewed, but never review
+	var eligible []int
+	var abstained []string
+	for i, resp := range successfulResponses {
+		if c.abstainsFromReview(resp) {
+			abstained = append(abstained, resp.Label())
+		} else {
+			eligible = append(eligible, i)
+		}
+	}
+	eligible, gated := c.gateReviewers(successfulResponses, eligible)
+	reviewers := selectReviewers(len(eligible), c.config.MaxReviewers, seed)
+	for i, r := range reviewers {
+		reviewers[i] = eligible[r]
+	}
+	assignments := selectReviewerPairs(len(successfulResponses), c.config.MaxReviewPairs, reviewers)
+	if result != nil {
+		result.Abstained = abstained
+		result.GatedReviewers = gated
+		result.ReviewCoverage = make(map[string]int)
+		for _, i := range reviewers {
+			result.Reviewers = append(result.Reviewers, successfulResponses[i].Label())
+		}
+		for _, assigned := range assignments {
+			result.ReviewPairs += len(assigned)
+			for _, j := range assigned {
+				result.ReviewCoverage[successfulResponses[j].Label()]++
+			}
+		}
+	}
+
+	// Build every review prompt up front, then run the reviews in parallel
+	type reviewTask struct {
+		reviewer   string
+		prompt     string
+		anonymized []copilot.Response
+	}
+	tasks := make([]reviewTask, 0, len(successfulResponses))
 	for i, reviewer := range successfulResponses {
+		if len(assignments[i]) == 0 {
+			continue
+		}
+
 		// Build anonymized responses (exclude the reviewer's own response)
-		anonymizedResponses := make([]copilot.Response, 0)
-		for j, resp := range successfulResponses {
-			if i != j {
-				anonymizedResponses = append(anonymizedResponses, resp)
-			}
+		anonymizedResponses := make([]copilot.Response, 0, len(assignments[i]))
+		for _, j := range assignments[i] {
+			anonymizedResponses = append(anonymizedResponses, successfulResponses[j])
 		}
-		
+
 		reviewPrompt := c.buildReviewPrompt(question, anonymizedResponses)
-		
+
 		// Store the review prompt for verbose output
 		if result != nil {
-			result.ReviewPrompts[reviewer.Model] = reviewPrompt
+			result.ReviewPrompts[reviewer.Label()] = reviewPrompt
 		}
-		
-		// Get review from this model
-		reviewContent, duration, err := c.client.AskSingleModel(
-			ctx,
-			reviewer.Model,
-			reviewPrompt,
-			c.config.Timeout,
-		)
-		
-		if progressCallback != nil {
-			progressCallback(reviewer.Model+" (review)", duration, err)
+
+		tasks = append(tasks, reviewTask{
+			reviewer:   reviewer.Label(),
+			prompt:     reviewPrompt,
+			anonymized: anonymizedResponses,
+		})
+	}
+
+	// Reviews still in flight are cancelled once the quorum agrees
+	reviewCtx, cancelReviews := context.WithCancel(ctx)
+	defer cancelReviews()
+
+	var (
+		wg            sync.WaitGroup
+		mu            sync.Mutex
+		topVotes      = make(map[string]int)
+		quorumReached bool
+	)
+	reviews := make([]Review, len(tasks))
+
+	// Likely-fast reviewers are launched first; reviews keep the task order
+	reviewerMembers := make([]copilot.Member, len(tasks))
+	for i, task := range tasks {
+		reviewerMembers[i] = c.member(task.reviewer)
+	}
+	for _, i := range latencyOrder(reviewerMembers, c.config.LatencyHints) {
+		task := tasks[i]
+		wg.Add(1)
+		go func(idx int, task reviewTask) {
+			defer wg.Done()
+
+			// Get review from this model
+			reviewer := c.member(task.reviewer)
+
+			// A panic fails this review only; the others still count
+			defer recoverPanic(func(err error) {
+				mu.Lock()
+				reviews[idx] = Review{ReviewerModel: task.reviewer, Error: err}
+				mu.Unlock()
+				emit(Event{Type: EventFinished, Phase: PhaseReview, Member: reviewer.Name, Model: reviewer.Model, Err: err})
+			})
+			emit(Event{Type: EventStarted, Phase: PhaseReview, Member: reviewer.Name, Model: reviewer.Model})
+			c.tracePrompt(PhaseReview, task.reviewer, task.prompt)
+			reviewContent, duration, err := c.client.AskSingleModel(
+				reviewCtx,
+				reviewer,
+				task.prompt,
+				c.config.Timeout,
+			)
+
+			review := func() Review {
+				mu.Lock()
+				defer mu.Unlock()
+
+				review := Review{
+					ReviewerModel: task.reviewer,
+					Duration:      duration,
+					Error:         err,
+				}
+
+				if err != nil && quorumReached {
+					review.Error = nil
+					review.Skipped = true
+					reviews[idx] = review
+					return review
+				}
+
+				if err == nil {
+					review.Rankings, review.Malformed = parseLabeledRankings(reviewContent, c.responseLabels(task.anonymized))
+					if len(review.Rankings) == 0 && c.config.StrictReviews {
+						review.Error = ErrUnrankedReview
+					}
+					for k := range review.Rankings {
+						review.Rankings[k].Model = task.anonymized[review.Rankings[k].ResponseIndex].Label()
+					}
+
+					if top := topRanked(review.Rankings); top != "" && c.config.ReviewQuorum > 0 {
+						topVotes[top]++
+						if topVotes[top] >= c.config.ReviewQuorum && !quorumReached {
+							quorumReached = true
+							cancelReviews()
+						}
+					}
+				}
+				reviews[idx] = review
+				return review
+			}()
+
+			if review.Skipped {
+				emit(Event{Type: EventFinished, Phase: PhaseReview, Member: reviewer.Name, Model: reviewer.Model, Duration: duration, Err: ErrReviewSkipped})
+				return
+			}
+			emit(Event{Type: EventFinished, Phase: PhaseReview, Member: reviewer.Name, Model: reviewer.Model, Duration: duration, Err: review.Error})
+		}(i, task)
+	}
+	wg.Wait()
+
+	if result != nil {
+		for _, review := range reviews {
+			if review.Skipped {
+				result.SkippedReviews++
+			}
 		}
-		
-		review := Review{
-			ReviewerModel: reviewer.Model,
-			Duration:      duration,
-			Error:         err,
+	}
+
+	return reviews
+}
+
+// topRanked returns the model a review placed first, if any
+func topRanked(rankings []Ranking) string {
+	for _, ranking := range rankings {
+		if ranking.Rank == 1 {
+			return ranking.Model
 		}
-		
-		if err == nil {
-			// Parse rankings from the review content
-			// For simplicity, we'll store the raw review for now
-			// In a production system, you'd parse structured rankings
-			review.Rankings = c.parseRankings(reviewContent, len(anonymizedResponses))
+	}
+	return ""
+}
+
+// abstainsFromReview reports whether NoReviewFrom names the member or its model
+func (c *Council) abstainsFromReview(resp copilot.Response) bool {
+	for _, name := range c.config.NoReviewFrom {
+		if name == resp.Label() || name == resp.Model {
+			return true
 		}
-		
-		reviews = append(reviews, review)
 	}
-	
-	return reviews
+	return false
+}
+
+// gateReviewers drops the reviewers whose own answer is too thin to trust
+// their judgment, with ReviewerMinWords: fewer words than that, or a
+// refusal. Their answers are still reviewed. When no reviewer would be
+// left, nobody is gated. It returns the remaining reviewers and the labels
+// of the gated ones.
+func (c *Council) gateReviewers(responses []copilot.Response, eligible []int) ([]int, []string) {
+	if c.config.ReviewerMinWords <= 0 {
+		return eligible, nil
+	}
+	var kept []int
+	var gated []string
+	for _, i := range eligible {
+		resp := responses[i]
+		if resp.Refused || len(wordPattern.FindAllStringIndex(resp.Content, -1)) < c.config.ReviewerMinWords {
+			gated = append(gated, resp.Label())
+		} else {
+			kept = append(kept, i)
+		}
+	}
+	if len(kept) == 0 {
+		return eligible, nil
+	}
+	return kept, gated
+}
+
+// selectReviewers picks the indices of the members that act as reviewers:
+// all n of them, or a seeded random subset of max members when max is below n.
+func selectReviewers(n, max int, seed int64) []int {
+	if max <= 0 || max >= n {
+		reviewers := make([]int, n)
+		for i := range reviewers {
+			reviewers[i] = i
+		}
+		return reviewers
+	}
+
+	reviewers := rand.New(rand.NewSource(seed)).Perm(n)[:max]
+	sort.Ints(reviewers)
+	return reviewers
+}
+
+// selectReviewPairs decides which responses each reviewer evaluates.
+// It returns, for every reviewer index, the indices of the responses it reviews.
+// Pairs are taken round-robin by offset (i reviews i+1, then i+2, ...) so that
+// when maxPairs truncates the full matrix, every response is reviewed a similar
+// number of times. maxPairs <= 0 selects all n*(n-1) pairs.
+func selectReviewPairs(n, maxPairs int) [][]int {
+	return selectReviewerPairs(n, maxPairs, selectReviewers(n, 0, 0))
+}
+
+// selectReviewerPairs is selectReviewPairs restricted to the given reviewers;
+// the others are assigned nothing. The full matrix is len(reviewers)*(n-1) pairs.
+func selectReviewerPairs(n, maxPairs int, reviewers []int) [][]int {
+	assignments := make([][]int, n)
+	total := len(reviewers) * (n - 1)
+	if maxPairs <= 0 || maxPairs > total {
+		maxPairs = total
+	}
+
+	selected := 0
+	for offset := 1; offset < n && selected < maxPairs; offset++ {
+		for _, i := range reviewers {
+			if selected >= maxPairs {
+				break
+			}
+			assignments[i] = append(assignments[i], (i+offset)%n)
+			selected++
+		}
+	}
+
+	for i := range assignments {
+		sort.Ints(assignments[i])
+	}
+	return assignments
 }
 
 // buildReviewPrompt creates the prompt for peer review
 func (c *Council) buildReviewPrompt(question string, anonymizedResponses []copilot.Response) string {
 	var sb strings.Builder
 	
-	sb.WriteString(fmt.Sprintf(`You are an expert evaluator. Below are %d different responses to the question: "%s"
+	role, responses := "an expert evaluator", "responses"
+	if c.neutral() {
+		role, responses = "an evaluator", "candidate answers"
+	}
+	labeling := fmt.Sprintf("The responses are anonymized (labeled %s).", describeLabels(c.config.ReviewLabels))
+	if c.config.RevealAuthors {
+		labeling = "Each response is labeled with the name of the model that wrote it."
+	}
+	sb.WriteString(fmt.Sprintf(`You are %s. Below are %d different %s to the question: "%s"
 
-The responses are anonymized (labeled Response A, Response B, etc.).
+%s
 
-`, len(anonymizedResponses), question))
+`, role, len(anonymizedResponses), responses, question, labeling))
 	
-	labels := []string{"A", "B", "C", "D", "E", "F", "G", "H"}
+	labels := c.responseLabels(anonymizedResponses)
 	for i, resp := range anonymizedResponses {
-		if i < len(labels) {
-			sb.WriteString(fmt.Sprintf("## Response %s:\n", labels[i]))
-			sb.WriteString(resp.Content)
-			sb.WriteString("\n\n")
+		sb.WriteString(fmt.Sprintf("## Response %s:\n", labels[i]))
+		if resp.Partial {
+			sb.WriteString(partialNote)
 		}
+		sb.WriteString(resp.Content)
+		sb.WriteString("\n\n")
 	}
 	
-	sb.WriteString(`Please evaluate these responses based on:
+	sb.WriteString(fmt.Sprintf(`Please evaluate these %s based on:
 1. Accuracy of information
 2. Depth of insight
 3. Practical usefulness
 4. Clarity and conciseness
 
-Rank the responses from best to worst (1 = best) and explain your reasoning for each.
+Rank the %s from best to worst (1 = best) and explain your reasoning for each.
 Format your response as:
 
 Ranking:
@@ -236,7 +1405,7 @@ Ranking:
 2. Response [Y]: [brief reasoning]
 ...
 
-Be objective and focus on the quality of the content, not stylistic preferences.`)
+Be objective and focus on the quality of the content, not stylistic preferences.`, responses, responses))
 	
 	return sb.String()
 }
@@ -244,52 +1413,140 @@ Be objective and focus on the quality of the content, not stylistic preferences.
 // parseRankings extracts ranking information from review content
 // This is a simplified parser - in production you'd want more robust parsing
 func (c *Council) parseRankings(reviewContent string, numResponses int) []Ranking {
-	rankings := make([]Ranking, 0)
-	
-	// For now, store a simple representation
-	// A more sophisticated implementation would parse the actual rankings
-	lines := strings.Split(reviewContent, "\n")
-	labels := []string{"A", "B", "C", "D", "E", "F", "G", "H"}
-	
+	rankings, _ := parseLabeledRankings(reviewContent, reviewLabels(c.config.ReviewLabels, numResponses))
+	return rankings
+}
+
+// rankPrefix matches the rank a ranking line starts with, as in "2." or "2:"
+var rankPrefix = regexp.MustCompile(`^\W*(\d+)\s*[.:)]`)
+
+// parseLabeledRankings extracts the rankings of the responses shown under
+// the given labels, in order. A review holds at most one ranking per
+// response: lines repeating a rank or a response are rejected and described
+// in the returned problems, so a reviewer ignoring the format cannot inflate
+// the consensus or the aggregation prompt.
+func parseLabeledRankings(reviewContent string, labels []string) ([]Ranking, []string) {
+	rankings := make([]Ranking, 0, len(labels))
+	var problems []string
+	patterns := make([]*regexp.Regexp, len(labels))
+	for i, label := range labels {
+		patterns[i] = labelPattern(label)
+	}
+
+	ranked := make(map[int]bool, len(labels))
 	rank := 1
-	for _, line := range lines {
+	for _, line := range strings.Split(reviewContent, "\n") {
 		line = strings.TrimSpace(line)
-		for i, label := range labels {
-			if i >= numResponses {
-				break
+
+		// The line ranks the response it names first, so reasoning that
+		// compares it with another response does not steal the rank
+		index, at := -1, len(line)
+		for i, pattern := range patterns {
+			if loc := pattern.FindStringIndex(line); loc != nil && loc[0] < at {
+				index, at = i, loc[0]
 			}
-			if strings.Contains(line, "Response "+label) && (strings.Contains(line, fmt.Sprintf("%d.", rank)) || strings.Contains(line, fmt.Sprintf("%d:", rank))) {
-				rankings = append(rankings, Ranking{
-					ResponseIndex: i,
-					Rank:          rank,
-					Reasoning:     line,
-				})
-				rank++
-				break
+		}
+		if index < 0 {
+			continue
+		}
+		if len(rankings) == len(labels) {
+			if rankPrefix.MatchString(line) {
+				problems = appendProblem(problems, fmt.Sprintf("more than %d rankings", len(labels)))
 			}
+			continue
 		}
+
+		// Drop the label before looking for the rank, so "2. Response 1:" is not read as rank 1
+		rest := patterns[index].ReplaceAllString(line, "")
+		if !strings.Contains(rest, fmt.Sprintf("%d.", rank)) && !strings.Contains(rest, fmt.Sprintf("%d:", rank)) {
+			if m := rankPrefix.FindStringSubmatch(line); m != nil {
+				if n, _ := strconv.Atoi(m[1]); n < rank {
+					problems = appendProblem(problems, fmt.Sprintf("rank %d given twice", n))
+				}
+			}
+			continue
+		}
+		if ranked[index] {
+			problems = appendProblem(problems, fmt.Sprintf("Response %s ranked twice", labels[index]))
+			continue
+		}
+		rankings = append(rankings, Ranking{
+			ResponseIndex: index,
+			Rank:          rank,
+			Reasoning:     line,
+		})
+		ranked[index] = true
+		rank++
 	}
-	
-	return rankings
+	return rankings, problems
+}
+
+// appendProblem adds problem to problems unless it is already there
+func appendProblem(problems []string, problem string) []string {
+	for _, p := range problems {
+		if p == problem {
+			return problems
+		}
+	}
+	return append(problems, problem)
+}
+
+// finalAnswerHeading matches the "## Final Answer" heading requested by SplitReasoning
+var finalAnswerHeading = regexp.MustCompile(`(?im)^#{1,6}\s*final answer:?\s*$`)
+
+// reasoningHeading matches the "## Reasoning" heading requested by SplitReasoning
+var reasoningHeading = regexp.MustCompile(`(?im)^#{1,6}\s*reasoning:?\s*$`)
+
+// splitAggregatorOutput separates the chairman's reasoning from its final answer.
+// Without a Final Answer heading the whole output is treated as the answer.
+func splitAggregatorOutput(output string) (reasoning, answer string) {
+	loc := finalAnswerHeading.FindStringIndex(output)
+	if loc == nil {
+		return "", strings.TrimSpace(output)
+	}
+
+	reasoning = output[:loc[0]]
+	if r := reasoningHeading.FindStringIndex(reasoning); r != nil {
+		reasoning = reasoning[r[1]:]
+	}
+	return strings.TrimSpace(reasoning), strings.TrimSpace(output[loc[1]:])
 }
 
 // buildAggregationPrompt creates the prompt for the aggregator model with review results
 func (c *Council) buildAggregationPrompt(originalQuestion string, responses []copilot.Response, reviews []Review) string {
 	var sb strings.Builder
 
-	sb.WriteString(fmt.Sprintf(`You are the Chairman of an AI Council. Multiple AI models have answered the following question, and then peer-reviewed each other's responses.
+	process, evidence := "peer-reviewed each other's responses", "responses AND their peer reviews"
+	if c.config.Mode == ModeRoundtable {
+		process, evidence = "revised their answers after reading each other's", "revised responses"
+	}
+	responsesHeading, reviewsHeading, reviewsIntro := "Council Members' Responses", "Peer Review Results", "Each model reviewed the others' responses."
+	if c.neutral() {
+		responsesHeading, reviewsHeading, reviewsIntro = "Candidate Answers", "Evaluations", "Each model evaluated the other candidate answers."
+		if c.config.Mode != ModeRoundtable {
+			evidence = "candidate answers AND their evaluations"
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf(`You are %s. Multiple AI models have answered the following question, and then %s.
 
 Original Question: "%s"
 
-`, originalQuestion))
+`, c.aggregatorRole(), process, originalQuestion))
 
 	// Show all responses
-	sb.WriteString("## Council Members' Responses:\n\n")
+	sb.WriteString(fmt.Sprintf("## %s:\n\n", responsesHeading))
 	for i, resp := range responses {
-		sb.WriteString(fmt.Sprintf("### Response %d - %s:\n", i+1, resp.Model))
+		sb.WriteString(fmt.Sprintf("### Response %d - %s:\n", i+1, resp.Label()))
 		if resp.Error != nil {
 			sb.WriteString(fmt.Sprintf("(Error: %v)\n\n", resp.Error))
 		} else {
+			if resp.Partial {
+				sb.WriteString(partialNote)
+			}
+			if resp.Refused {
+				sb.WriteString(refusalNote)
+			}
 			sb.WriteString(resp.Content)
 			sb.WriteString("\n\n")
 		}
@@ -297,8 +1554,8 @@ Original Question: "%s"
 	
 	// Show peer review results
 	if len(reviews) > 0 {
-		sb.WriteString("## Peer Review Results:\n\n")
-		sb.WriteString("Each model reviewed the others' responses. Here are their evaluations:\n\n")
+		sb.WriteString(fmt.Sprintf("## %s:\n\n", reviewsHeading))
+		sb.WriteString(reviewsIntro + " Here are their evaluations:\n\n")
 		
 		for _, review := range reviews {
 			if review.Error == nil && len(review.Rankings) > 0 {
@@ -311,21 +1568,69 @@ Original Question: "%s"
 		}
 	}
 
-	sb.WriteString(`## Your Task as Chairman:
+	c.writeChairmanTask(&sb, evidence)
+	return sb.String()
+}
 
-Based on the council members' responses AND their peer reviews:
+// writeChairmanTask ends an aggregation prompt with the chairman's guidance,
+// if any, the chairman's task based on the given evidence, and the requested
+// answer format
+func (c *Council) writeChairmanTask(sb *strings.Builder, evidence string) {
+	guidanceHeading, taskHeading, basis := "Chairman's Guidance", "Your Task as Chairman", "the council members' "+evidence
+	if c.neutral() {
+		guidanceHeading, taskHeading, basis = "Guidance", "Your Task", "the "+evidence
+	}
+	if guidance := strings.TrimSpace(c.config.AggregatorContext); guidance != "" {
+		sb.WriteString(fmt.Sprintf("## %s:\n\n", guidanceHeading))
+		sb.WriteString(c.framed("Apply this guidance when writing the final answer. The council members did not see it.\n\n"))
+		sb.WriteString(guidance)
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString(fmt.Sprintf(`## %s:
 
-1. Synthesize the BEST answer to the original question
-2. Take a CLEAR, DECISIVE stance - avoid vague "it depends" answers
-3. If there are multiple valid approaches, CHOOSE the best one and explain why
-4. Provide ACTIONABLE recommendations
-5. Support your decision with the strongest evidence from the responses
+Based on %s:
 
-The council expects a definitive answer. Be confident in your conclusion.
+`, taskHeading, basis))
+	sb.WriteString(c.framed(chairmanTask(c.config.ChairmanPersona)))
+	sb.WriteString("\n\n")
+	if instruction := lengthInstruction(c.config.AnswerLength, c.config.MaxAnswerWords); instruction != "" {
+		sb.WriteString(instruction)
+		sb.WriteString("\n\n")
+	}
+	if c.config.CollectSources {
+		sb.WriteString(c.framed(citeInstruction))
+		sb.WriteString("\n\n")
+	}
 
-Your final answer:`)
+	if c.config.SplitReasoning {
+		sb.WriteString(`Structure your reply in exactly two sections:
 
-	return sb.String()
+## Reasoning
+(how you weighed the responses and reviews)
+
+## Final Answer
+(the answer itself, written for the person who asked)`)
+	} else {
+		sb.WriteString("Your final answer:")
+	}
+}
+
+// DedupeModels removes repeated model names, keeping the first occurrence.
+// It returns the unique models and the duplicates that were dropped.
+func DedupeModels(models []string) ([]string, []string) {
+	seen := make(map[string]bool, len(models))
+	unique := make([]string, 0, len(models))
+	var duplicates []string
+	for _, model := range models {
+		if seen[model] {
+			duplicates = append(duplicates, model)
+			continue
+		}
+		seen[model] = true
+		unique = append(unique, model)
+	}
+	return unique, duplicates
 }
 
 // DefaultModels returns the default set of models to use
@@ -1,7 +1,16 @@
 package council
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
 )
 
 func TestDefaultModels(t *testing.T) {
@@ -34,3 +43,3044 @@ func TestDefaultAggregator(t *testing.T) {
 		t.Errorf("Expected aggregator %s, got %s", expected, aggregator)
 	}
 }
+
+func TestBuildInitialPrompt(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		suffix string
+		want   string
+	}{
+		{"no wrapping", "", "", "What is Go?"},
+		{"prefix only", "Be concise.", "", "Be concise.\n\nWhat is Go?"},
+		{"suffix only", "", "Cite sources.", "What is Go?\n\nCite sources."},
+		{"both", "Be concise.", "Cite sources.", "Be concise.\n\nWhat is Go?\n\nCite sources."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Council{config: Config{PromptPrefix: tt.prefix, PromptSuffix: tt.suffix}}
+			if got := c.buildInitialPrompt("What is Go?"); got != tt.want {
+				t.Errorf("buildInitialPrompt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectReviewPairs(t *testing.T) {
+	t.Run("no cap selects full matrix", func(t *testing.T) {
+		assignments := selectReviewPairs(4, 0)
+		for i, assigned := range assignments {
+			if len(assigned) != 3 {
+				t.Errorf("reviewer %d: expected 3 reviews, got %d", i, len(assigned))
+			}
+			for _, j := range assigned {
+				if j == i {
+					t.Errorf("reviewer %d was assigned its own response", i)
+				}
+			}
+		}
+	})
+
+	t.Run("cap keeps coverage balanced", func(t *testing.T) {
+		for _, maxPairs := range []int{1, 4, 5, 7, 10} {
+			assignments := selectReviewPairs(5, maxPairs)
+
+			total := 0
+			coverage := make([]int, 5)
+			for i, assigned := range assignments {
+				total += len(assigned)
+				for _, j := range assigned {
+					if j == i {
+						t.Errorf("maxPairs=%d: reviewer %d was assigned its own response", maxPairs, i)
+					}
+					coverage[j]++
+				}
+			}
+
+			if total != maxPairs {
+				t.Errorf("maxPairs=%d: selected %d pairs", maxPairs, total)
+			}
+
+			minCov, maxCov := coverage[0], coverage[0]
+			for _, c := range coverage {
+				if c < minCov {
+					minCov = c
+				}
+				if c > maxCov {
+					maxCov = c
+				}
+			}
+			if maxCov-minCov > 1 {
+				t.Errorf("maxPairs=%d: unbalanced coverage %v", maxPairs, coverage)
+			}
+		}
+	})
+
+	t.Run("cap above matrix size is ignored", func(t *testing.T) {
+		total := 0
+		for _, assigned := range selectReviewPairs(3, 100) {
+			total += len(assigned)
+		}
+		if total != 6 {
+			t.Errorf("expected 6 pairs, got %d", total)
+		}
+	})
+}
+
+func TestComputeConsensusNormalizesCoverage(t *testing.T) {
+	reviews := []Review{
+		{ReviewerModel: "a", Rankings: []Ranking{
+			{Model: "b", Rank: 1},
+			{Model: "c", Rank: 2},
+		}},
+		{ReviewerModel: "b", Rankings: []Ranking{
+			{Model: "c", Rank: 1},
+			{Model: "a", Rank: 2},
+		}},
+		{ReviewerModel: "c", Rankings: []Ranking{
+			{Model: "b", Rank: 1},
+			{Model: "a", Rank: 2},
+		}},
+	}
+
+	scores := computeConsensus(reviews, nil)
+	if len(scores) != 3 {
+		t.Fatalf("expected 3 scores, got %d", len(scores))
+	}
+	if scores[0].Model != "b" || scores[0].Score != 1 {
+		t.Errorf("expected b to lead with score 1, got %+v", scores[0])
+	}
+	if scores[len(scores)-1].Model != "a" || scores[len(scores)-1].Score != 0 {
+		t.Errorf("expected a to trail with score 0, got %+v", scores[len(scores)-1])
+	}
+}
+
+func TestExecuteWithMockClient(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"failing": {{Error: "failed to create session for model failing"}},
+		},
+	}, false)
+
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "failing"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.ModelResponses) != 3 || result.ModelResponses[2].Error == nil {
+		t.Errorf("expected the failing model to report an error: %+v", result.ModelResponses)
+	}
+	if len(result.Reviews) != 2 {
+		t.Errorf("expected 2 reviews from the successful models, got %d", len(result.Reviews))
+	}
+	if result.AggregatedResponse == "" {
+		t.Error("expected an aggregated response")
+	}
+}
+
+func TestExecuteStrictReviews(t *testing.T) {
+	for _, strict := range []bool{false, true} {
+		client := copilot.NewMockClient(&copilot.MockFixture{
+			Models: map[string][]copilot.MockResponse{
+				"model-a": {{Content: "Answer A"}, {Content: "1. Response A\n2. Response B"}, {Content: "Synthesis"}},
+				"model-b": {{Content: "Answer B"}, {Content: "They are all fine."}},
+				"model-c": {{Content: "Answer C"}, {Content: "1. Response B\n2. Response A"}},
+			},
+		}, false)
+
+		c := NewCouncilWithClient(Config{
+			Models:        []string{"model-a", "model-b", "model-c"},
+			Aggregator:    "model-a",
+			Timeout:       time.Minute,
+			StrictReviews: strict,
+		}, client)
+
+		result := c.Execute(context.Background(), "What is Go?", nil, nil)
+		if result.Error != nil {
+			t.Fatalf("unexpected error: %v", result.Error)
+		}
+		err := result.Reviews[1].Error
+		if strict && !errors.Is(err, ErrUnrankedReview) {
+			t.Errorf("expected the unranked review to fail in strict mode, got %v", err)
+		}
+		if !strict && err != nil {
+			t.Errorf("expected the unranked review to be tolerated, got %v", err)
+		}
+		if result.Reviews[0].Error != nil || result.Reviews[2].Error != nil {
+			t.Error("ranked reviews should succeed")
+		}
+	}
+}
+
+func TestExecuteScriptedReviews(t *testing.T) {
+	// Each model answers, then reviews the other two (labeled A and B in council order)
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a": {
+				{Content: "Answer A", DurationMs: 300},
+				{Content: "Ranking:\n1. Response B: C is thorough\n2. Response A: B is thin", DurationMs: 100},
+			},
+			"model-b": {
+				{Content: "Answer B", DurationMs: 100},
+				{Content: "Ranking:\n1. Response B: C covers the edge cases\n2. Response A: A is fine", DurationMs: 100},
+			},
+			"model-c": {
+				{Content: "Answer C", DurationMs: 200},
+				{Content: "Ranking:\n1. Response A: A is solid\n2. Response B: B misses the point", DurationMs: 100},
+			},
+		},
+	}, false)
+
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: AutoAggregator,
+		Timeout:    time.Minute,
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if got := result.ModelResponses[0].Duration; got != 300*time.Millisecond {
+		t.Errorf("expected the scripted latency, got %v", got)
+	}
+
+	var consensus []string
+	for _, score := range result.Consensus {
+		consensus = append(consensus, fmt.Sprintf("%s=%.1f", score.Model, score.Score))
+	}
+	if got := strings.Join(consensus, " "); got != "model-c=1.0 model-a=0.5 model-b=0.0" {
+		t.Errorf("unexpected consensus: %s", got)
+	}
+	if result.AggregatorModel != "model-c" {
+		t.Errorf("expected the top-ranked member to chair, got %s", result.AggregatorModel)
+	}
+	if result.Favorite != "model-c" || result.FavoriteVotes != 2 {
+		t.Errorf("expected model-c as council favorite with 2 votes, got %s (%d)", result.Favorite, result.FavoriteVotes)
+	}
+	for _, want := range []string{"**model-a's Review:**\n- 1. Response B: C is thorough", "- 1. Response B: C covers the edge cases", "### Response 3 - model-c:\nAnswer C"} {
+		if !strings.Contains(result.AggregationPrompt, want) {
+			t.Errorf("expected %q in the aggregation prompt:\n%s", want, result.AggregationPrompt)
+		}
+	}
+}
+
+func TestExecuteAllModelsFail(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a": {{Error: "boom"}},
+			"model-b": {{Error: "boom"}},
+		},
+	}, false)
+
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error == nil {
+		t.Error("expected an error when all models fail")
+	}
+}
+
+func TestExecuteRequireAllModels(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"failing": {{Error: "unknown model"}},
+		},
+	}, false)
+
+	c := NewCouncilWithClient(Config{
+		Models:           []string{"model-a", "model-b", "failing"},
+		Aggregator:       "chairman",
+		Timeout:          time.Minute,
+		RequireAllModels: true,
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if !errors.Is(result.Error, ErrMissingModels) || !strings.Contains(result.Error.Error(), "failing") {
+		t.Fatalf("expected ErrMissingModels naming the failed model, got %v", result.Error)
+	}
+	if len(result.Reviews) != 0 || result.AggregatedResponse != "" {
+		t.Error("the run should stop before peer review")
+	}
+}
+
+func TestExecutePartialResponses(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"flaky": {{Partial: "Go is a compiled", Error: "stream reset"}},
+		},
+	}
+
+	for _, include := range []bool{false, true} {
+		c := NewCouncilWithClient(Config{
+			Models:         []string{"model-a", "model-b", "flaky"},
+			Aggregator:     "chairman",
+			Timeout:        time.Minute,
+			IncludePartial: include,
+		}, copilot.NewMockClient(fixture, false))
+
+		result := c.Execute(context.Background(), "What is Go?", nil, nil)
+		if result.Error != nil {
+			t.Fatalf("include=%v: unexpected error: %v", include, result.Error)
+		}
+		flaky := result.ModelResponses[2]
+		if !flaky.Partial || flaky.Content != "Go is a compiled" {
+			t.Fatalf("include=%v: expected the partial content to be kept, got %+v", include, flaky)
+		}
+		if (flaky.Error == nil) != include {
+			t.Errorf("include=%v: unexpected error state %v", include, flaky.Error)
+		}
+		if got := strings.Contains(result.AggregationPrompt, "Go is a compiled\n"); got != include {
+			t.Errorf("include=%v: partial answer in the aggregation prompt = %v", include, got)
+		}
+		if got := strings.Contains(result.AggregationPrompt, partialNote); got != include {
+			t.Errorf("include=%v: partial note in the aggregation prompt = %v", include, got)
+		}
+	}
+}
+
+func TestResolveAggregator(t *testing.T) {
+	fixed := &Council{config: Config{Aggregator: "gpt-4.1"}}
+	if got := fixed.resolveAggregator(Result{}); got != "gpt-4.1" {
+		t.Errorf("expected configured aggregator, got %s", got)
+	}
+
+	auto := &Council{config: Config{Aggregator: AutoAggregator}}
+	result := Result{
+		ModelResponses: []copilot.Response{
+			{Model: "model-a", Content: "a"},
+			{Model: "model-b", Content: "b"},
+		},
+		Consensus: []ConsensusScore{{Model: "model-b", Score: 1}, {Model: "model-a", Score: 0}},
+	}
+	if got := auto.resolveAggregator(result); got != "model-b" {
+		t.Errorf("expected consensus leader model-b, got %s", got)
+	}
+
+	result.Consensus = nil
+	if got := auto.resolveAggregator(result); got != "model-a" {
+		t.Errorf("expected first successful member without consensus, got %s", got)
+	}
+}
+
+func TestDedupeModels(t *testing.T) {
+	unique, duplicates := DedupeModels([]string{"gpt-5.2", "claude-sonnet-4.5", "gpt-5.2", "gpt-5.2"})
+
+	if len(unique) != 2 || unique[0] != "gpt-5.2" || unique[1] != "claude-sonnet-4.5" {
+		t.Errorf("unexpected unique models: %v", unique)
+	}
+	if len(duplicates) != 2 {
+		t.Errorf("expected 2 dropped duplicates, got %v", duplicates)
+	}
+
+	unique, duplicates = DedupeModels([]string{"a", "b"})
+	if len(unique) != 2 || duplicates != nil {
+		t.Errorf("expected no change without duplicates, got %v / %v", unique, duplicates)
+	}
+}
+
+func TestCouncilFavorite(t *testing.T) {
+	first := func(reviewer, model string) Review {
+		return Review{ReviewerModel: reviewer, Rankings: []Ranking{{Model: model, Rank: 1}, {Model: "other", Rank: 2}}}
+	}
+
+	favorite, votes := councilFavorite([]Review{first("a", "b"), first("b", "c"), first("c", "b"), {ReviewerModel: "d", Error: errors.New("boom")}})
+	if favorite != "b" || votes != 2 {
+		t.Errorf("councilFavorite = %s, %d; want b, 2", favorite, votes)
+	}
+	if favorite, _ := councilFavorite([]Review{first("a", "b"), first("b", "a")}); favorite != "" {
+		t.Errorf("expected no favorite on a tie, got %s", favorite)
+	}
+}
+
+func TestParseClaimChecks(t *testing.T) {
+	reply := `Here are the verdicts:
+
+CLAIM: Go has goroutines.
+VERDICT: SUPPORTED
+BY: model-a, model-b
+
+**CLAIM:** "Go was released in 2009."
+**VERDICT:** UNSUPPORTED
+**BY:** none
+
+CLAIM: Channels are typed: chan int carries ints.
+BY: model-a`
+	checks := parseClaimChecks(reply)
+	if len(checks) != 3 {
+		t.Fatalf("expected 3 claims, got %+v", checks)
+	}
+	if checks[0].Claim != "Go has goroutines." || !checks[0].Supported || strings.Join(checks[0].Members, ",") != "model-a,model-b" {
+		t.Errorf("unexpected first check: %+v", checks[0])
+	}
+	if checks[1].Claim != "Go was released in 2009." || checks[1].Supported || len(checks[1].Members) != 0 {
+		t.Errorf("unexpected second check: %+v", checks[1])
+	}
+	if checks[2].Claim != "Channels are typed: chan int carries ints." || checks[2].Supported {
+		t.Errorf("a claim without a verdict should count as unsupported: %+v", checks[2])
+	}
+}
+
+func TestExecuteVerifyQuotes(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"chairman": {
+			{Content: "Go has goroutines. Go was released in 2009! Go is fun."},
+			{Content: "CLAIM: Go has goroutines.\nVERDICT: SUPPORTED\nBY: model-a\n\nCLAIM: Go was released in 2009!\nVERDICT: UNSUPPORTED\nBY: none\n\nCLAIM: Go runs on Mars.\nVERDICT: UNSUPPORTED\nBY: none"},
+		},
+	}}
+	var prompts []string
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		VerifyQuotes: true,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseVerify {
+				prompts = append(prompts, prompt)
+			}
+		},
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "Tell me about Go", nil, nil)
+	if result.Error != nil || result.VerifyError != nil {
+		t.Fatalf("unexpected error: %v / %v", result.Error, result.VerifyError)
+	}
+	if want := "Go has goroutines. Go was released in 2009! [unsupported] Go is fun."; result.AggregatedResponse != want {
+		t.Errorf("answer = %q, want %q", result.AggregatedResponse, want)
+	}
+	if len(result.ClaimChecks) != 3 || !result.ClaimChecks[1].Flagged || result.ClaimChecks[2].Flagged {
+		t.Errorf("expected the second claim flagged and the third (not in the answer) unflagged, got %+v", result.ClaimChecks)
+	}
+	if len(prompts) != 1 || !strings.Contains(prompts[0], "mock answer from model-b") || !strings.Contains(prompts[0], "Go is fun.") {
+		t.Errorf("expected one verify prompt with the responses and the answer, got %q", prompts)
+	}
+
+	// A reply the checker did not format leaves the answer as it was
+	fixture.Models["chairman"] = []copilot.MockResponse{{Content: "Go is fun."}, {Content: "Looks fine to me."}}
+	c = NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		VerifyQuotes: true,
+	}, copilot.NewMockClient(fixture, false))
+	result = c.Execute(context.Background(), "Tell me about Go", nil, nil)
+	if result.VerifyError == nil || result.AggregatedResponse != "Go is fun." || result.Error != nil {
+		t.Errorf("expected a verify error and the unmarked answer, got %q, %v / %v", result.AggregatedResponse, result.VerifyError, result.Error)
+	}
+}
+
+func TestExecuteVerifyQuotesSkippedAggregation(t *testing.T) {
+	// A unanimous answer is checked by a member other than its author
+	var checkers []string
+	c := NewCouncilWithClient(Config{
+		Models:         []string{"model-a", "model-b"},
+		Aggregator:     "chairman",
+		Timeout:        time.Minute,
+		VerifyQuotes:   true,
+		SmartAggregate: true,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseVerify {
+				checkers = append(checkers, model)
+			}
+		},
+	}, copilot.NewMockClient(&copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a": {{Content: "Go is fun."}, {Content: "1. Response A\n2. Response B"}, {Content: "NONE"}},
+		"model-b": {{Content: "Go is fun."}, {Content: "1. Response A\n2. Response B"}, {Content: "NONE"}},
+	}}, false))
+	result := c.Execute(context.Background(), "Tell me about Go", nil, nil)
+	if result.AggregationSkipped != SkipUnanimous {
+		t.Fatalf("expected aggregation skipped as unanimous, got %q", result.AggregationSkipped)
+	}
+	if len(checkers) != 1 || checkers[0] == result.AggregatorModel || result.VerifyError != nil {
+		t.Errorf("expected one check by a member other than %s, got %v (%v)", result.AggregatorModel, checkers, result.VerifyError)
+	}
+
+	// The only answer has nobody else to check it
+	checkers = nil
+	c = NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		VerifyQuotes: true,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseVerify {
+				checkers = append(checkers, model)
+			}
+		},
+	}, copilot.NewMockClient(&copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-b": {{Error: "HTTP 500"}},
+	}}, false))
+	result = c.Execute(context.Background(), "Tell me about Go", nil, nil)
+	if result.AggregationSkipped != SkipSingleSuccess {
+		t.Fatalf("expected the single-success fallback, got %q", result.AggregationSkipped)
+	}
+	if !errors.Is(result.VerifyError, ErrNoChecker) || len(checkers) != 0 {
+		t.Errorf("expected verification skipped with ErrNoChecker, got %v after %d checks", result.VerifyError, len(checkers))
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	var first, second []EventType
+	handler := FanOut(
+		func(event Event) { first = append(first, event.Type) },
+		nil,
+		func(event Event) { second = append(second, event.Type) },
+	)
+	handler(Event{Type: EventPhaseStart})
+	handler(Event{Type: EventFinished})
+	if len(first) != 2 || len(second) != 2 || first[1] != EventFinished || second[0] != EventPhaseStart {
+		t.Errorf("expected both handlers to see every event in order, got %v and %v", first, second)
+	}
+}
+
+func TestPluralityWinners(t *testing.T) {
+	first := func(reviewer, model string) Review {
+		return Review{ReviewerModel: reviewer, Rankings: []Ranking{{Model: "other", Rank: 2}, {Model: model, Rank: 1}}}
+	}
+	tests := []struct {
+		name        string
+		reviews     []Review
+		wantWinners string
+		wantVotes   int
+	}{
+		{name: "no reviews"},
+		{name: "clear winner", reviews: []Review{first("a", "gpt-5.2"), first("b", "gpt-5.2"), first("c", "claude"), first("d", "gpt-5.2")}, wantWinners: "gpt-5.2", wantVotes: 3},
+		{name: "two-way tie", reviews: []Review{first("a", "gpt-5.2"), first("b", "claude"), first("c", "claude"), first("d", "gpt-5.2")}, wantWinners: "claude,gpt-5.2", wantVotes: 2},
+		{name: "everyone tied", reviews: []Review{first("a", "c"), first("b", "a"), first("c", "b")}, wantWinners: "a,b,c", wantVotes: 1},
+		{name: "failed and unranked reviews do not vote", reviews: []Review{
+			first("a", "claude"),
+			{ReviewerModel: "b", Rankings: []Ranking{{Model: "gpt-5.2", Rank: 1}}, Error: errors.New("boom")},
+			{ReviewerModel: "c"},
+		}, wantWinners: "claude", wantVotes: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winners, votes := pluralityWinners(tt.reviews)
+			if strings.Join(winners, ",") != tt.wantWinners || votes != tt.wantVotes {
+				t.Errorf("pluralityWinners = %v, %d; want %s, %d", winners, votes, tt.wantWinners, tt.wantVotes)
+			}
+		})
+	}
+}
+
+func TestComputeConsensusReviewerWeights(t *testing.T) {
+	reviews := []Review{
+		{ReviewerModel: "judge-a", Rankings: []Ranking{
+			{Model: "x", Rank: 1},
+			{Model: "y", Rank: 2},
+		}},
+		{ReviewerModel: "judge-b", Rankings: []Ranking{
+			{Model: "x", Rank: 1},
+			{Model: "y", Rank: 2},
+		}},
+		{ReviewerModel: "judge-c", Rankings: []Ranking{
+			{Model: "y", Rank: 1},
+			{Model: "x", Rank: 2},
+		}},
+	}
+
+	unweighted := computeConsensus(reviews, nil)
+	if unweighted[0].Model != "x" {
+		t.Fatalf("expected x to win unweighted, got %s", unweighted[0].Model)
+	}
+
+	weighted := computeConsensus(reviews, map[string]float64{"judge-c": 3})
+	if weighted[0].Model != "y" {
+		t.Errorf("expected y to win when judge-c weighs 3, got %s", weighted[0].Model)
+	}
+	if weighted[0].Score != 0.6 {
+		t.Errorf("expected weighted score 0.6, got %f", weighted[0].Score)
+	}
+
+	muted := computeConsensus(reviews, map[string]float64{"judge-a": 0, "judge-b": 0})
+	if muted[0].Model != "y" || muted[0].Reviews != 1 {
+		t.Errorf("expected zero-weight reviewers to be ignored, got %+v", muted)
+	}
+}
+
+// stalledReviewClient is a mock client whose single-model requests to the
+// stalled models block until their context is cancelled
+type stalledReviewClient struct {
+	*copilot.MockClient
+	stalled map[string]bool
+}
+
+func (c *stalledReviewClient) AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	if !c.stalled[member.Model] {
+		return c.MockClient.AskSingleModel(ctx, member, question, timeout)
+	}
+	select {
+	case <-ctx.Done():
+		return "", 0, ctx.Err()
+	case <-time.After(10 * time.Second):
+		return "", 0, errors.New("review was never cancelled")
+	}
+}
+
+func TestConductPeerReviewQuorum(t *testing.T) {
+	agree := "Ranking:\n1. Response B: strongest\n2. Response A: fine\n3. Response C: weakest\n"
+	script := []copilot.MockResponse{{Content: "an answer", DurationMs: 1}, {Content: agree, DurationMs: 1}}
+	client := &stalledReviewClient{
+		MockClient: copilot.NewMockClient(&copilot.MockFixture{
+			Models: map[string][]copilot.MockResponse{
+				"a":        script,
+				"b":        script,
+				"c":        script,
+				"d":        script,
+				"chairman": {{Content: "final", DurationMs: 1}},
+			},
+		}, false),
+		stalled: map[string]bool{"c": true, "d": true},
+	}
+
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"a", "b", "c", "d"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		ReviewQuorum: 2,
+	}, client)
+
+	var (
+		mu      sync.Mutex
+		pending = make(map[string]int)
+		skipped []string
+	)
+	result := c.ExecuteWithEvents(context.Background(), "q", func(event Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := fmt.Sprintf("%s/%s/%d", event.Phase, event.Member, event.Attempt)
+		switch event.Type {
+		case EventStarted:
+			pending[key]++
+		case EventFinished:
+			pending[key]--
+			if errors.Is(event.Err, ErrReviewSkipped) {
+				skipped = append(skipped, event.Member)
+			}
+		}
+	})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	if result.SkippedReviews != 2 {
+		t.Errorf("expected 2 skipped reviews, got %d", result.SkippedReviews)
+	}
+	for _, review := range result.Reviews {
+		slow := review.ReviewerModel == "c" || review.ReviewerModel == "d"
+		if slow != review.Skipped {
+			t.Errorf("review by %s: skipped=%v", review.ReviewerModel, review.Skipped)
+		}
+		if review.Skipped && review.Error != nil {
+			t.Errorf("skipped review by %s should not report an error: %v", review.ReviewerModel, review.Error)
+		}
+	}
+	if len(result.Consensus) == 0 || result.Consensus[0].Model != "c" {
+		t.Errorf("expected c to lead the consensus, got %+v", result.Consensus)
+	}
+
+	// Every request that started also finished, skipped reviews included
+	for key, n := range pending {
+		if n != 0 {
+			t.Errorf("%s: %d more started than finished events", key, n)
+		}
+	}
+	sort.Strings(skipped)
+	if strings.Join(skipped, ",") != "c,d" {
+		t.Errorf("expected finished events marking c and d skipped, got %v", skipped)
+	}
+}
+
+func TestExecuteTracesPrompts(t *testing.T) {
+	var mu sync.Mutex
+	stages := make(map[string]int)
+
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		TracePrompt: func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			if prompt == "" {
+				t.Errorf("empty prompt traced for %s/%s", stage, model)
+			}
+			stages[stage]++
+		},
+	}, copilot.NewMockClient(nil, false))
+
+	c.Execute(context.Background(), "What is Go?", nil, nil)
+
+	if stages["query"] != 1 || stages["review"] != 2 || stages["aggregation"] != 1 {
+		t.Errorf("unexpected traced prompts per stage: %v", stages)
+	}
+}
+
+func TestExecuteAggregatorContext(t *testing.T) {
+	const rubric = "Score answers on correctness first, brevity second."
+	for _, mode := range []string{ModeCouncil, ModeProposer} {
+		var mu sync.Mutex
+		prompts := make(map[string][]string)
+
+		c := NewCouncilWithClient(Config{
+			Models:            []string{"model-a", "model-b"},
+			Aggregator:        "chairman",
+			Timeout:           time.Minute,
+			Mode:              mode,
+			AggregatorContext: rubric + "\n",
+			TracePrompt: func(stage, model, prompt string) {
+				mu.Lock()
+				defer mu.Unlock()
+				prompts[stage] = append(prompts[stage], prompt)
+			},
+		}, copilot.NewMockClient(nil, false))
+
+		if result := c.Execute(context.Background(), "What is Go?", nil, nil); result.Error != nil {
+			t.Fatalf("%s: unexpected error: %v", mode, result.Error)
+		}
+		for stage, traced := range prompts {
+			for _, prompt := range traced {
+				if got := strings.Contains(prompt, rubric); got != (stage == PhaseAggregation) {
+					t.Errorf("%s: guidance in the %s prompt = %v", mode, stage, got)
+				}
+			}
+		}
+		if len(prompts[PhaseAggregation]) == 0 || !strings.Contains(prompts[PhaseAggregation][0], "## Chairman's Guidance:") {
+			t.Errorf("%s: expected a guidance block in the aggregation prompt", mode)
+		}
+	}
+}
+
+func TestSplitAggregatorOutput(t *testing.T) {
+	tests := []struct {
+		name          string
+		output        string
+		wantReasoning string
+		wantAnswer    string
+	}{
+		{
+			name:          "both sections",
+			output:        "## Reasoning\nModel A was most accurate.\n\n## Final Answer\nUse channels.\n",
+			wantReasoning: "Model A was most accurate.",
+			wantAnswer:    "Use channels.",
+		},
+		{
+			name:          "preamble without reasoning heading",
+			output:        "Let me weigh the responses.\n### final answer:\nUse channels.",
+			wantReasoning: "Let me weigh the responses.",
+			wantAnswer:    "Use channels.",
+		},
+		{
+			name:       "no delimiter falls back to whole output",
+			output:     "  Use channels.\n",
+			wantAnswer: "Use channels.",
+		},
+		{
+			name:       "inline mention is not a heading",
+			output:     "The final answer is to use channels.",
+			wantAnswer: "The final answer is to use channels.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reasoning, answer := splitAggregatorOutput(tt.output)
+			if reasoning != tt.wantReasoning {
+				t.Errorf("reasoning = %q, want %q", reasoning, tt.wantReasoning)
+			}
+			if answer != tt.wantAnswer {
+				t.Errorf("answer = %q, want %q", answer, tt.wantAnswer)
+			}
+		})
+	}
+}
+
+func TestExecuteRetriesEmptyResponses(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"flaky": {{Content: ""}, {Content: "a real answer"}},
+			"mute":  {{Content: ""}},
+		},
+	}
+
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"flaky", "mute", "steady"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		RetryEmpty: 2,
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+
+	flaky, mute := result.ModelResponses[0], result.ModelResponses[1]
+	if flaky.Error != nil || flaky.Content != "a real answer" {
+		t.Errorf("expected flaky model to recover on re-ask, got %+v", flaky)
+	}
+	if !errors.Is(mute.Error, copilot.ErrEmptyResponse) {
+		t.Errorf("expected mute model to fail with ErrEmptyResponse, got %v", mute.Error)
+	}
+	if flaky.Retries != 1 || mute.Retries != 2 || result.ModelResponses[2].Retries != 0 {
+		t.Errorf("unexpected retry counts: flaky %d, mute %d, steady %d", flaky.Retries, mute.Retries, result.ModelResponses[2].Retries)
+	}
+}
+
+func TestExecuteMarksEmptyResponsesWithoutRetry(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"flaky": {{Content: ""}, {Content: "a real answer"}},
+		},
+	}
+
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"flaky", "steady"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if !errors.Is(result.ModelResponses[0].Error, copilot.ErrEmptyResponse) {
+		t.Errorf("expected empty response error without --retry-empty, got %+v", result.ModelResponses[0])
+	}
+}
+
+// progressOnlyClient is a ModelClient without AskMultipleModelsWithResponses
+type progressOnlyClient struct {
+	ModelClient
+}
+
+func TestExecuteProgressOnlyClient(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a": {{Content: "Answer A"}},
+		"model-b": {{Content: "Answer B"}},
+	}}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		QueryOnly:  true,
+	}, progressOnlyClient{copilot.NewMockClient(fixture, false)})
+
+	answers := map[string]string{}
+	result := c.ExecuteWithEvents(context.Background(), "q", func(event Event) {
+		if event.Type == EventFinished && event.Phase == PhaseQuery {
+			answers[event.Member] = event.Content
+		}
+	})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if answers["model-a"] != "Answer A" || answers["model-b"] != "Answer B" {
+		t.Errorf("expected every answer handed over once the batch is in, got %v", answers)
+	}
+}
+
+// memberRecorder records the members a council asks
+type memberRecorder struct {
+	*copilot.MockClient
+	mu    sync.Mutex
+	asked []copilot.Member
+}
+
+func (r *memberRecorder) AskMultipleModelsWithResponses(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response {
+	r.mu.Lock()
+	r.asked = append(r.asked, members...)
+	r.mu.Unlock()
+	return r.MockClient.AskMultipleModelsWithResponses(ctx, members, question, timeout, onResponse)
+}
+
+func (r *memberRecorder) AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	r.mu.Lock()
+	r.asked = append(r.asked, member)
+	r.mu.Unlock()
+	return r.MockClient.AskSingleModel(ctx, member, question, timeout)
+}
+
+func TestExecuteLatencyHints(t *testing.T) {
+	client := &memberRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"slow", "unknown", "fast"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		QueryOnly:  true,
+		LatencyHints: map[string]time.Duration{
+			"slow": 9 * time.Second,
+			"fast": time.Second,
+		},
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	var asked []string
+	for _, member := range client.asked {
+		asked = append(asked, member.Model)
+	}
+	if got := strings.Join(asked, ","); got != "fast,slow,unknown" {
+		t.Errorf("expected the fastest model launched first, got %s", got)
+	}
+	for i, want := range []string{"slow", "unknown", "fast"} {
+		if got := result.ModelResponses[i].Model; got != want {
+			t.Errorf("response %d: expected %s, got %s", i, want, got)
+		}
+	}
+}
+
+func TestExecuteMaxConcurrencyLatencyOrder(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:         []string{"slow", "unknown", "fast"},
+		Aggregator:     "chairman",
+		Timeout:        time.Minute,
+		QueryOnly:      true,
+		MaxConcurrency: 1,
+		LatencyHints: map[string]time.Duration{
+			"slow": 9 * time.Second,
+			"fast": time.Second,
+		},
+	}, copilot.NewMockClient(nil, false))
+
+	// One slot: each member answers before the next is granted it
+	var answered []string
+	result := c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		if event.Type == EventFinished && event.Phase == PhaseQuery {
+			answered = append(answered, event.Model)
+		}
+	})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if got := strings.Join(answered, ","); got != "fast,slow,unknown" {
+		t.Errorf("expected the fastest model granted the slot first, got %s", got)
+	}
+}
+
+// streamRecorder is a mock client that records whether streaming was turned on
+type streamRecorder struct {
+	*copilot.MockClient
+	streaming bool
+}
+
+func (s *streamRecorder) SetStreaming(on bool) { s.streaming = on }
+
+func TestStreamIsOptIn(t *testing.T) {
+	plain := &streamRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	NewCouncilWithClient(Config{Models: []string{"model-a"}}, plain)
+	if plain.streaming {
+		t.Error("sessions should not stream unless Config.Stream is set")
+	}
+
+	streamed := &streamRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	NewCouncilWithClient(Config{Models: []string{"model-a"}, Stream: true}, streamed)
+	if !streamed.streaming {
+		t.Error("Config.Stream should turn streaming on")
+	}
+}
+
+func TestExecuteExecModel(t *testing.T) {
+	client := &memberRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "echo"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		ExecModels: []ExecModel{{Name: "echo", Command: []string{"cat"}}},
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if got := result.ModelResponses[1]; got.Model != "echo" || got.Content != "What is Go?" {
+		t.Errorf("expected the command to echo the prompt, got %+v", got)
+	}
+	if len(result.Reviews) != 2 {
+		t.Errorf("expected the exec model to take part in peer review, got %d reviews", len(result.Reviews))
+	}
+	for _, member := range client.asked {
+		if member.Model == "echo" {
+			t.Error("exec models must not reach the wrapped client")
+		}
+	}
+}
+
+func TestExecModelFailure(t *testing.T) {
+	model := ExecModel{Name: "broken", Command: []string{"sh", "-c", "echo oops >&2; exit 3"}}
+	_, _, err := model.Ask(context.Background(), "What is Go?", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "oops") {
+		t.Errorf("expected the command's stderr in the error, got %v", err)
+	}
+
+	slow := ExecModel{Name: "slow", Command: []string{"sleep", "5"}}
+	if _, _, err := slow.Ask(context.Background(), "What is Go?", 50*time.Millisecond); !errors.Is(err, copilot.ErrTimeout) {
+		t.Errorf("expected ErrTimeout, got %v", err)
+	}
+}
+
+func TestExecuteWithPersonas(t *testing.T) {
+	client := &memberRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	c := NewCouncilWithClient(Config{
+		Models: []string{"model-a"},
+		Members: []copilot.Member{
+			{Name: "skeptic", Model: "model-a", SystemPrompt: "Doubt everything."},
+			{Name: "optimist", Model: "model-a", SystemPrompt: "Expect the best."},
+		},
+		Aggregator: AutoAggregator,
+		Timeout:    time.Minute,
+	}, client)
+
+	result := c.Execute(context.Background(), "Should we rewrite it?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	for _, resp := range result.ModelResponses {
+		if resp.Model != "model-a" || (resp.Label() != "skeptic" && resp.Label() != "optimist") {
+			t.Errorf("unexpected member response: %+v", resp)
+		}
+	}
+	if result.ReviewCoverage["skeptic"] != 1 || result.ReviewCoverage["optimist"] != 1 {
+		t.Errorf("review coverage should be keyed by persona: %v", result.ReviewCoverage)
+	}
+	if result.AggregatorModel != "model-a" {
+		t.Errorf("auto aggregator should resolve to the persona's model, got %q", result.AggregatorModel)
+	}
+
+	prompts := make(map[string]int)
+	for _, member := range client.asked {
+		if member.SystemPrompt != "" {
+			prompts[member.Name]++
+		}
+	}
+	// Each persona answers and reviews under its own system prompt
+	if prompts["skeptic"] != 2 || prompts["optimist"] != 2 {
+		t.Errorf("expected personas to keep their system prompts, got %v", prompts)
+	}
+}
+
+func TestExecuteWithEvents(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(nil, false))
+
+	var (
+		mu     sync.Mutex
+		events []Event
+	)
+	result := c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	counts := make(map[string]int)
+	var phases []string
+	for _, event := range events {
+		if event.Time.IsZero() {
+			t.Errorf("event %s has no timestamp", event.Type)
+		}
+		counts[string(event.Type)+"/"+event.Phase]++
+		if event.Type == EventFinished && event.Phase == PhaseQuery && event.Content == "" {
+			t.Errorf("finished query for %s should carry the answer", event.Member)
+		}
+		if event.Type == EventPhaseStart {
+			phases = append(phases, event.Phase)
+		}
+	}
+	if strings.Join(phases, ",") != "query,review,aggregation" {
+		t.Errorf("unexpected phase order: %v", phases)
+	}
+	for key, want := range map[string]int{
+		"started/query":        2,
+		"finished/query":       2,
+		"started/review":       2,
+		"finished/review":      2,
+		"started/aggregation":  1,
+		"finished/aggregation": 1,
+	} {
+		if counts[key] != want {
+			t.Errorf("expected %d %s events, got %d", want, key, counts[key])
+		}
+	}
+	if last := events[len(events)-1]; last.Type != EventFinished || last.Phase != PhaseAggregation || last.Member != "chairman" {
+		t.Errorf("expected aggregation to complete last, got %+v", last)
+	}
+}
+
+func TestExecuteProgressCallbackAdapter(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(nil, false))
+
+	var (
+		mu       sync.Mutex
+		progress []string
+		phases   []string
+	)
+	c.Execute(context.Background(), "What is Go?",
+		func(model string, duration time.Duration, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			progress = append(progress, model)
+		},
+		func(phase string, modelCount int) {
+			phases = append(phases, fmt.Sprintf("%s:%d", phase, modelCount))
+		})
+
+	sort.Strings(progress)
+	want := []string{"model-a", "model-a (review)", "model-b", "model-b (review)"}
+	if strings.Join(progress, ",") != strings.Join(want, ",") {
+		t.Errorf("progress = %v, want %v", progress, want)
+	}
+	if strings.Join(phases, ",") != "review:2" {
+		t.Errorf("phases = %v, want [review:2]", phases)
+	}
+
+	progress = nil
+	handle := progressEvents(func(model string, duration time.Duration, err error) {
+		progress = append(progress, model)
+	}, nil)
+	handle(Event{Type: EventFinished, Phase: PhaseQuery, Member: "model-a", Attempt: 1})
+	handle(Event{Type: EventFinished, Phase: PhaseRoundtable, Member: "model-a"})
+	handle(Event{Type: EventFinished, Phase: PhaseAggregation, Member: "chairman"})
+	if strings.Join(progress, ",") != "model-a (retry),model-a (revised)" {
+		t.Errorf("progress = %v, want the retry and the revision", progress)
+	}
+}
+
+func TestSelectReviewers(t *testing.T) {
+	if got := selectReviewers(4, 0, 1); len(got) != 4 {
+		t.Errorf("expected every member to review without a cap, got %v", got)
+	}
+	if got := selectReviewers(3, 5, 1); len(got) != 3 {
+		t.Errorf("cap above council size should select everyone, got %v", got)
+	}
+
+	first := selectReviewers(6, 2, 42)
+	if len(first) != 2 || first[0] >= first[1] {
+		t.Fatalf("expected 2 sorted reviewers, got %v", first)
+	}
+	if again := selectReviewers(6, 2, 42); first[0] != again[0] || first[1] != again[1] {
+		t.Errorf("same seed should select the same reviewers: %v vs %v", first, again)
+	}
+
+	// Sampled reviewers still cover every response
+	assignments := selectReviewerPairs(6, 0, first)
+	coverage := make(map[int]bool)
+	total := 0
+	for i, assigned := range assignments {
+		if len(assigned) > 0 && i != first[0] && i != first[1] {
+			t.Errorf("member %d was not selected but reviews %v", i, assigned)
+		}
+		total += len(assigned)
+		for _, j := range assigned {
+			coverage[j] = true
+		}
+	}
+	if total != 2*5 || len(coverage) != 6 {
+		t.Errorf("expected 10 pairs covering all 6 responses, got %d pairs covering %d", total, len(coverage))
+	}
+}
+
+func TestExecuteMaxReviewers(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"a", "b", "c", "d"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		MaxReviewers: 2,
+		ReviewerSeed: 7,
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Reviewers) != 2 || len(result.Reviews) != 2 {
+		t.Errorf("expected 2 reviewers, got %v (%d reviews)", result.Reviewers, len(result.Reviews))
+	}
+	if result.ReviewPairs != 6 {
+		t.Errorf("expected 2*3 review pairs, got %d", result.ReviewPairs)
+	}
+}
+
+// sessionCounter is a mock client that reports session cleanup
+type sessionCounter struct {
+	*copilot.MockClient
+	destroyed, failed int
+}
+
+func (s *sessionCounter) SessionsDestroyed() (int, int) { return s.destroyed, s.failed }
+
+func TestSessionsDestroyed(t *testing.T) {
+	c := NewCouncilWithClient(Config{}, copilot.NewMockClient(nil, false))
+	if _, _, ok := c.SessionsDestroyed(); ok {
+		t.Error("mock client does not track sessions")
+	}
+
+	c = NewCouncilWithClient(Config{}, &sessionCounter{MockClient: copilot.NewMockClient(nil, false), destroyed: 5, failed: 2})
+	if n, failed, ok := c.SessionsDestroyed(); !ok || n != 5 || failed != 2 {
+		t.Errorf("SessionsDestroyed() = %d, %d, %v; want 5, 2, true", n, failed, ok)
+	}
+}
+
+// throttlingClient is a mock client that reports rate limiting on every batch of queries
+type throttlingClient struct {
+	*copilot.MockClient
+	handler copilot.ThrottleHandler
+}
+
+func (c *throttlingClient) OnThrottle(handler copilot.ThrottleHandler) { c.handler = handler }
+
+func (c *throttlingClient) AskMultipleModelsWithResponses(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response {
+	c.handler(1, 2*time.Second)
+	return c.MockClient.AskMultipleModelsWithResponses(ctx, members, question, timeout, onResponse)
+}
+
+func TestExecuteReportsThrottling(t *testing.T) {
+	var throttled []Event
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, &throttlingClient{MockClient: copilot.NewMockClient(nil, false)})
+
+	c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		if event.Type == EventThrottled {
+			throttled = append(throttled, event)
+		}
+	})
+	if len(throttled) != 1 || throttled[0].Count != 1 || throttled[0].Duration != 2*time.Second {
+		t.Errorf("expected one throttling event, got %+v", throttled)
+	}
+}
+
+// panickingClient is a mock client whose single-model requests panic for one model
+type panickingClient struct {
+	*copilot.MockClient
+	model string
+}
+
+func (c *panickingClient) AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	if member.Model == c.model {
+		panic("nil pointer dereference")
+	}
+	return c.MockClient.AskSingleModel(ctx, member, question, timeout)
+}
+
+func TestExecuteRecoversReviewPanic(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, &panickingClient{MockClient: copilot.NewMockClient(nil, false), model: "model-b"})
+
+	var failed []Event
+	result := c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		if event.Type == EventFinished && event.Phase == PhaseReview && event.Err != nil {
+			failed = append(failed, event)
+		}
+	})
+	if result.Error != nil || result.AggregatedResponse == "" {
+		t.Fatalf("a panicking reviewer should not fail the run: %v", result.Error)
+	}
+	for _, review := range result.Reviews {
+		var panicErr *copilot.PanicError
+		if review.ReviewerModel == "model-b" {
+			if !errors.As(review.Error, &panicErr) || panicErr.Error() != "internal error: nil pointer dereference" {
+				t.Errorf("expected model-b's review to fail with the panic, got %v", review.Error)
+			}
+		} else if review.Error != nil {
+			t.Errorf("%s's review should succeed, got %v", review.ReviewerModel, review.Error)
+		}
+	}
+	if len(failed) != 1 || failed[0].Model != "model-b" {
+		t.Errorf("expected one failed review event for model-b, got %+v", failed)
+	}
+}
+
+func TestExecuteRecoversRoundtablePanic(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		Mode:       ModeRoundtable,
+	}, &panickingClient{MockClient: copilot.NewMockClient(nil, false), model: "model-b"})
+
+	var failed []Event
+	result := c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		if event.Type == EventFinished && event.Phase == PhaseRoundtable && event.Err != nil {
+			failed = append(failed, event)
+		}
+	})
+	if result.Error != nil || result.AggregatedResponse == "" {
+		t.Fatalf("a panicking revision should not fail the run: %v", result.Error)
+	}
+	var panicErr *copilot.PanicError
+	if len(failed) != 1 || failed[0].Model != "model-b" || !errors.As(failed[0].Err, &panicErr) {
+		t.Errorf("expected one failed revision event for model-b with the panic, got %+v", failed)
+	}
+	if result.Revisions != 2 {
+		t.Errorf("expected the other two members to revise, got %d revisions", result.Revisions)
+	}
+	for _, resp := range result.ModelResponses {
+		if resp.Model == "model-b" && (resp.Error != nil || resp.Content == "") {
+			t.Errorf("model-b should keep its original answer, got %+v", resp)
+		}
+	}
+}
+
+func TestSkipPending(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"fast-a":   {{Content: "Answer A", DurationMs: 10}, {Content: "1. Response A", DurationMs: 10}},
+			"fast-b":   {{Content: "Answer B", DurationMs: 10}, {Content: "1. Response A", DurationMs: 10}},
+			"slow":     {{Content: "Too late", DurationMs: 60000}},
+			"chairman": {{Content: "Synthesis", DurationMs: 10}},
+		},
+	}, true)
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"fast-a", "fast-b", "slow"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, client)
+	if c.SkipPending() {
+		t.Error("nothing to skip before the run")
+	}
+
+	var mu sync.Mutex
+	answered := 0
+	result := c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		if event.Type != EventFinished || event.Phase != PhaseQuery {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if answered++; answered == 2 {
+			go c.SkipPending()
+		}
+	})
+
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if err := result.ModelResponses[2].Error; !errors.Is(err, ErrSkipped) {
+		t.Errorf("expected the slow member skipped, got %v", err)
+	}
+	for _, resp := range result.ModelResponses[:2] {
+		if resp.Error != nil {
+			t.Errorf("%s answered before the skip, got %v", resp.Model, resp.Error)
+		}
+	}
+	if result.AggregatedResponse != "Synthesis" {
+		t.Errorf("expected the run to go on with the answers in, got %q", result.AggregatedResponse)
+	}
+	if c.SkipPending() {
+		t.Error("nothing to skip after the run")
+	}
+}
+
+func TestExecuteSendsHistoryBeforeQuestion(t *testing.T) {
+	var sent string
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		PromptPrefix: "Be brief.",
+		History: []Message{
+			{Role: RoleUser, Content: "Name a prime."},
+			{Role: RoleAssistant, Content: "7"},
+		},
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseQuery {
+				sent = prompt
+			}
+		},
+	}, copilot.NewMockClient(nil, false))
+
+	c.Execute(context.Background(), "Name an even prime.", nil, nil)
+
+	user := strings.Index(sent, "User: Name a prime.")
+	assistant := strings.Index(sent, "Assistant: 7")
+	prefix := strings.Index(sent, "Be brief.")
+	question := strings.Index(sent, "Name an even prime.")
+	if user < 0 || assistant < 0 || prefix < 0 || question < 0 {
+		t.Fatalf("prompt is missing parts:\n%s", sent)
+	}
+	if !(user < assistant && assistant < prefix && prefix < question) {
+		t.Errorf("history should precede the question in order:\n%s", sent)
+	}
+}
+
+func TestReviewLabels(t *testing.T) {
+	tests := []struct {
+		scheme string
+		n      int
+		want   []string
+	}{
+		{LabelsAlpha, 3, []string{"A", "B", "C"}},
+		{"", 2, []string{"A", "B"}},
+		{LabelsNumeric, 3, []string{"1", "2", "3"}},
+		{LabelsGreek, 3, []string{"Alpha", "Beta", "Gamma"}},
+	}
+	for _, tt := range tests {
+		if got := reviewLabels(tt.scheme, tt.n); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("reviewLabels(%q, %d) = %v, want %v", tt.scheme, tt.n, got, tt.want)
+		}
+	}
+
+	// Large councils get distinct labels in every scheme
+	for _, scheme := range LabelSchemes {
+		labels := reviewLabels(scheme, 60)
+		seen := make(map[string]bool)
+		for _, label := range labels {
+			if seen[label] {
+				t.Errorf("%s: duplicate label %q", scheme, label)
+			}
+			seen[label] = true
+		}
+	}
+	if got := reviewLabels(LabelsAlpha, 28)[26:]; got[0] != "AA" || got[1] != "AB" {
+		t.Errorf("expected alpha labels to continue with AA, AB; got %v", got)
+	}
+}
+
+func TestParseRankingsLabelSchemes(t *testing.T) {
+	tests := []struct {
+		scheme string
+		review string
+	}{
+		{LabelsAlpha, "Ranking:\n1. Response B: clearer\n2. Response A: vaguer"},
+		{LabelsNumeric, "Ranking:\n1. Response 2: clearer\n2. Response 1: vaguer"},
+		{LabelsGreek, "Ranking:\n1. Response Beta: clearer\n2. Response Alpha: vaguer"},
+	}
+	for _, tt := range tests {
+		c := &Council{config: Config{ReviewLabels: tt.scheme}}
+		rankings := c.parseRankings(tt.review, 2)
+		if len(rankings) != 2 || rankings[0].ResponseIndex != 1 || rankings[1].ResponseIndex != 0 {
+			t.Errorf("%s: unexpected rankings %+v", tt.scheme, rankings)
+		}
+		if prompt := c.buildReviewPrompt("q", []copilot.Response{{Content: "x"}, {Content: "y"}}); !strings.Contains(prompt, "## Response "+reviewLabels(tt.scheme, 2)[1]+":") {
+			t.Errorf("%s: review prompt does not use the scheme:\n%s", tt.scheme, prompt)
+		}
+	}
+
+	// "Response A" must not match "Response AB" in a large council
+	c := &Council{}
+	rankings := c.parseRankings("1. Response AB: best\n2. Response A: fine", 28)
+	if len(rankings) != 2 || rankings[0].ResponseIndex != 27 || rankings[1].ResponseIndex != 0 {
+		t.Errorf("unexpected rankings for two-letter labels: %+v", rankings)
+	}
+}
+
+func TestExecuteRevealAuthors(t *testing.T) {
+	var prompts []string
+	var mu sync.Mutex
+	c := NewCouncilWithClient(Config{
+		Models:        []string{"gpt-5", "gpt-5.2", "claude-sonnet-4.5"},
+		Aggregator:    "chairman",
+		Timeout:       time.Minute,
+		RevealAuthors: true,
+		TracePrompt: func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			if stage == PhaseReview && model == "claude-sonnet-4.5" {
+				prompts = append(prompts, prompt)
+			}
+		},
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(prompts) != 1 || !strings.Contains(prompts[0], "## Response gpt-5:") || !strings.Contains(prompts[0], "## Response gpt-5.2:") || strings.Contains(prompts[0], "anonymized") {
+		t.Errorf("expected responses labeled with model names, got %q", prompts)
+	}
+	for _, review := range result.Reviews {
+		if len(review.Rankings) != 2 {
+			t.Fatalf("%s: expected 2 rankings, got %+v", review.ReviewerModel, review.Rankings)
+		}
+		for _, ranking := range review.Rankings {
+			if ranking.Model == review.ReviewerModel || !strings.Contains(ranking.Reasoning, "Response "+ranking.Model+":") {
+				t.Errorf("%s: ranking attributed to the wrong model: %+v", review.ReviewerModel, ranking)
+			}
+		}
+	}
+}
+
+func TestParseLabeledRankingsModelNames(t *testing.T) {
+	rankings, _ := parseLabeledRankings("1. Response gpt-5.2: best\n2. Response gpt-5: fine", []string{"gpt-5", "gpt-5.2"})
+	if len(rankings) != 2 || rankings[0].ResponseIndex != 1 || rankings[1].ResponseIndex != 0 {
+		t.Errorf("\"gpt-5\" must not match \"gpt-5.2\": %+v", rankings)
+	}
+}
+
+func TestParseLabeledRankingsMalformed(t *testing.T) {
+	labels := []string{"A", "B", "C"}
+	tests := []struct {
+		name     string
+		review   string
+		want     string // Response indexes, in rank order
+		problems string
+	}{
+		{
+			name:   "well formed",
+			review: "Ranking:\n1. Response C: best\n2. Response A: good\n3. Response B: weakest",
+			want:   "2,0,1",
+		},
+		{
+			name:     "duplicate rank",
+			review:   "1. Response B: best\n1. Response A: also best\n2. Response C: fine",
+			want:     "1,2",
+			problems: "rank 1 given twice",
+		},
+		{
+			name:     "response ranked twice",
+			review:   "1. Response A: best\n2. Response A: still best\n2. Response B: fine",
+			want:     "0,1",
+			problems: "Response A ranked twice",
+		},
+		{
+			name:     "extra entries",
+			review:   "1. Response A: x\n2. Response B: y\n3. Response C: z\n4. Response A: again\n5. Response B: again",
+			want:     "0,1,2",
+			problems: "more than 3 rankings",
+		},
+		{
+			name:   "reasoning compares with another response",
+			review: "1. Response B: clearer than Response A\n2. Response A: vaguer than Response B",
+			want:   "1,0",
+		},
+	}
+	for _, tt := range tests {
+		rankings, problems := parseLabeledRankings(tt.review, labels)
+		var got []string
+		for i, ranking := range rankings {
+			if ranking.Rank != i+1 {
+				t.Errorf("%s: ranking %d has rank %d", tt.name, i, ranking.Rank)
+			}
+			got = append(got, fmt.Sprint(ranking.ResponseIndex))
+		}
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("%s: rankings %v, want %s", tt.name, got, tt.want)
+		}
+		if strings.Join(problems, ",") != tt.problems {
+			t.Errorf("%s: problems %q, want %q", tt.name, problems, tt.problems)
+		}
+	}
+}
+
+func TestExecuteSmartAggregate(t *testing.T) {
+	same := "Use a buffered channel to decouple the producer from the consumer and close it when done."
+	tests := []struct {
+		name    string
+		answers map[string]string
+		skip    bool
+	}{
+		{
+			name:    "unanimous",
+			answers: map[string]string{"a": same, "b": same + " ", "c": "  " + same},
+			skip:    true,
+		},
+		{
+			name: "divided",
+			answers: map[string]string{
+				"a": same,
+				"b": same,
+				"c": "Protect the shared slice with a mutex; channels are overkill for this case.",
+			},
+			skip: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{}}
+			for model, answer := range tt.answers {
+				fixture.Models[model] = []copilot.MockResponse{{Content: answer}, {Content: "Ranking:\n1. Response A: good\n2. Response B: fine"}}
+			}
+			c := NewCouncilWithClient(Config{
+				Models:         []string{"a", "b", "c"},
+				Aggregator:     "chairman",
+				Timeout:        time.Minute,
+				SmartAggregate: true,
+			}, copilot.NewMockClient(fixture, false))
+
+			result := c.Execute(context.Background(), "How do I share work between goroutines?", nil, nil)
+			if result.Error != nil {
+				t.Fatalf("unexpected error: %v", result.Error)
+			}
+			if skipped := result.AggregationSkipped != ""; skipped != tt.skip {
+				t.Fatalf("AggregationSkipped = %q, want skip=%v", result.AggregationSkipped, tt.skip)
+			}
+			if tt.skip {
+				if strings.TrimSpace(result.AggregatedResponse) != same || result.AggregationPrompt != "" {
+					t.Errorf("expected a member's answer without an aggregation call, got %q", result.AggregatedResponse)
+				}
+			} else if result.AggregatorModel != "chairman" {
+				t.Errorf("divided council should be aggregated by the chairman, got %q", result.AggregatorModel)
+			}
+		})
+	}
+}
+
+func TestExecuteRefineRounds(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		RefineRounds: 2,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseQuery {
+				mu.Lock()
+				queries = append(queries, prompt)
+				mu.Unlock()
+			}
+		},
+	}, copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"chairman": {{Content: "draft one"}, {Content: "draft two"}, {Content: "final"}},
+		},
+	}, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Rounds) != 3 || result.AggregatedResponse != "final" {
+		t.Fatalf("expected 3 rounds ending in the final answer, got %d rounds, %q", len(result.Rounds), result.AggregatedResponse)
+	}
+	if result.Rounds[0].AggregatedResponse != "draft one" || result.Rounds[1].AggregatedResponse != "draft two" {
+		t.Errorf("rounds should keep each draft: %q, %q", result.Rounds[0].AggregatedResponse, result.Rounds[1].AggregatedResponse)
+	}
+	if len(queries) != 3 || strings.Contains(queries[0], "draft") ||
+		!strings.Contains(queries[1], "draft one") || !strings.Contains(queries[2], "draft two") {
+		t.Errorf("each round should be asked to improve the previous draft: %q", queries)
+	}
+}
+
+func TestExecuteRefineRoundsStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		RefineRounds: 3,
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.ExecuteWithEvents(ctx, "What is Go?", func(event Event) {
+		if event.Type == EventFinished {
+			cancel()
+		}
+	})
+	if result.Error != nil || len(result.Rounds) != 1 {
+		t.Errorf("expected only the first round after cancellation, got %d rounds (err %v)", len(result.Rounds), result.Error)
+	}
+}
+
+func TestExecuteAppliesModelOptions(t *testing.T) {
+	client := &memberRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		ModelOptions: map[string]copilot.SendOptions{
+			"model-a":  {Mode: "immediate"},
+			"chairman": {Mode: "enqueue"},
+		},
+	}, client)
+
+	if result := c.Execute(context.Background(), "What is Go?", nil, nil); result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	want := map[string]string{"model-a": "immediate", "model-b": "", "chairman": "enqueue"}
+	for _, member := range client.asked {
+		if mode, ok := want[member.Model]; !ok || member.Options.Mode != mode {
+			t.Errorf("%s was asked with mode %q, want %q", member.Model, member.Options.Mode, mode)
+		}
+	}
+}
+
+func TestExecuteReplacesAggregatorThatFailedAsMember(t *testing.T) {
+	client := &memberRecorder{MockClient: copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"gpt-4.1": {{Error: "rate limit exceeded"}},
+		},
+	}, false)}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "gpt-4.1", "model-b"},
+		Aggregator: "gpt-4.1",
+		Timeout:    time.Minute,
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if result.ReplacedAggregator != "gpt-4.1" || result.AggregatorModel == "gpt-4.1" || result.AggregatorModel == "" {
+		t.Errorf("expected a fallback aggregator, got %q (replaced %q)", result.AggregatorModel, result.ReplacedAggregator)
+	}
+	calls := 0
+	for _, member := range client.asked {
+		if member.Model == "gpt-4.1" {
+			calls++
+		}
+	}
+	if calls != 1 { // only as a member in stage 1
+		t.Errorf("the failed aggregator should not be asked again, got %d calls", calls)
+	}
+
+	// A healthy aggregator that is also a member is kept
+	c = NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "model-a",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(nil, false))
+	if result := c.Execute(context.Background(), "What is Go?", nil, nil); result.ReplacedAggregator != "" || result.AggregatorModel != "model-a" {
+		t.Errorf("expected model-a to stay aggregator, got %q (replaced %q)", result.AggregatorModel, result.ReplacedAggregator)
+	}
+}
+
+func TestExecuteCollectsDissents(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:          []string{"model-a", "model-b", "model-c"},
+		Aggregator:      "chairman",
+		Timeout:         time.Minute,
+		CollectDissents: true,
+	}, copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a": {{Content: "Use channels."}, {Content: "Ranking:\n1. Response A: ok\n2. Response B: ok"}, {Content: "NONE."}},
+			"model-b": {{Content: "Use a mutex."}, {Content: "Ranking:\n1. Response A: ok\n2. Response B: ok"}, {Content: "A mutex is simpler for a single counter."}},
+			"model-c": {{Error: "timeout"}},
+		},
+	}, false))
+
+	result := c.Execute(context.Background(), "How do I share a counter?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Dissents) != 1 || result.Dissents[0].Member != "model-b" || !strings.Contains(result.Dissents[0].Point, "mutex") {
+		t.Errorf("expected only model-b to dissent, got %+v", result.Dissents)
+	}
+}
+
+// warmupRecorder is a mock client that records when sessions are warmed up
+type warmupRecorder struct {
+	*copilot.MockClient
+	warmed []string
+}
+
+func (w *warmupRecorder) Warmup(ctx context.Context, members []copilot.Member, timeout time.Duration) error {
+	for _, member := range members {
+		w.warmed = append(w.warmed, member.Name)
+	}
+	time.Sleep(10 * time.Millisecond)
+	return errors.New("session refused")
+}
+
+func TestExecuteWarmup(t *testing.T) {
+	client := &warmupRecorder{MockClient: copilot.NewMockClient(nil, false)}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		Warmup:     true,
+	}, client)
+
+	var phases []string
+	result := c.ExecuteWithEvents(context.Background(), "q", func(event Event) {
+		if event.Type == EventPhaseStart {
+			phases = append(phases, event.Phase)
+		}
+	})
+
+	if result.Error != nil {
+		t.Fatalf("a failed warmup should not fail the run: %v", result.Error)
+	}
+	if strings.Join(client.warmed, ",") != "model-a,model-b" {
+		t.Errorf("warmed = %v, want every member", client.warmed)
+	}
+	if len(phases) < 2 || phases[0] != PhaseWarmup || phases[1] != PhaseQuery {
+		t.Errorf("phases = %v, want warmup before query", phases)
+	}
+	if result.WarmupDuration < 10*time.Millisecond {
+		t.Errorf("WarmupDuration = %v, want the time spent warming up", result.WarmupDuration)
+	}
+
+	// Without the flag, sessions are created on demand
+	client.warmed = nil
+	c = NewCouncilWithClient(Config{Models: []string{"model-a"}, Aggregator: "chairman", Timeout: time.Minute}, client)
+	if result := c.Execute(context.Background(), "q", nil, nil); result.WarmupDuration != 0 || len(client.warmed) != 0 {
+		t.Errorf("warmup ran without Config.Warmup: %v, %v", result.WarmupDuration, client.warmed)
+	}
+}
+
+func TestExecuteQueryOnly(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		QueryOnly:    true,
+		RefineRounds: 1,
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.ModelResponses) != 2 {
+		t.Errorf("expected both answers, got %d", len(result.ModelResponses))
+	}
+	if len(result.Reviews) != 0 || result.AggregatedResponse != "" || len(result.Rounds) != 0 {
+		t.Errorf("QueryOnly should skip review, aggregation and refinement: %+v", result)
+	}
+}
+
+func TestExecuteRoundtable(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a": {{Content: "Answer A"}, {Content: "Answer A, with B's caveat"}},
+		"model-b": {{Content: "Answer B"}, {Error: "rate limit exceeded"}},
+	}}
+	var prompts []string
+	var mu sync.Mutex
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		Mode:       ModeRoundtable,
+		TracePrompt: func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			if stage == PhaseRoundtable && model == "model-a" {
+				prompts = append(prompts, prompt)
+			}
+		},
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	if len(prompts) != 1 || !strings.Contains(prompts[0], "Answer B") || !strings.Contains(prompts[0], "## Your Answer:\nAnswer A") {
+		t.Errorf("model-a should see its own and its colleague's answer, got %q", prompts)
+	}
+	if result.InitialResponses[0].Content != "Answer A" || result.ModelResponses[0].Content != "Answer A, with B's caveat" {
+		t.Errorf("expected the initial and revised answers, got %q and %q", result.InitialResponses[0].Content, result.ModelResponses[0].Content)
+	}
+	if result.ModelResponses[1].Content != "Answer B" || result.Revisions != 1 {
+		t.Errorf("a failed revision should keep the first answer: %q, %d revisions", result.ModelResponses[1].Content, result.Revisions)
+	}
+	if len(result.Reviews) != 0 {
+		t.Errorf("roundtable replaces peer review, got %d reviews", len(result.Reviews))
+	}
+	if !strings.Contains(result.AggregationPrompt, "revised their answers") || !strings.Contains(result.AggregationPrompt, "Answer A, with B's caveat") {
+		t.Errorf("the chairman should synthesize the revised answers, got %q", result.AggregationPrompt)
+	}
+}
+
+func TestExecuteProposer(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"proposer": {{Content: "Use channels everywhere"}},
+		"critic-a": {{Content: "Mutexes are simpler for shared counters"}},
+		"critic-b": {{Error: "rate limit exceeded"}},
+	}}
+	var critiquePrompts []string
+	var mu sync.Mutex
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"proposer", "critic-a", "critic-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		Mode:       ModeProposer,
+		TracePrompt: func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			if stage == PhaseCritique {
+				critiquePrompts = append(critiquePrompts, prompt)
+			}
+		},
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "How should goroutines share state?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	if len(result.ModelResponses) != 1 || result.ModelResponses[0].Model != "proposer" {
+		t.Fatalf("only the proposer should answer in stage 1, got %+v", result.ModelResponses)
+	}
+	if len(critiquePrompts) != 2 || !strings.Contains(critiquePrompts[0], "## Proposed Answer:\nUse channels everywhere") {
+		t.Errorf("each critic should see the proposal, got %q", critiquePrompts)
+	}
+	if len(result.Critiques) != 2 || result.Critiques[0].Content != "Mutexes are simpler for shared counters" || result.Critiques[1].Error == nil {
+		t.Errorf("unexpected critiques: %+v", result.Critiques)
+	}
+	if len(result.Reviews) != 0 || len(result.Consensus) != 0 {
+		t.Error("proposer mode replaces peer review")
+	}
+	for _, want := range []string{"## Proposal from proposer:", "### critic-a:\nMutexes are simpler", "(Error: rate limit exceeded)"} {
+		if !strings.Contains(result.AggregationPrompt, want) {
+			t.Errorf("expected %q in the aggregation prompt, got %q", want, result.AggregationPrompt)
+		}
+	}
+	if result.AggregatedResponse == "" {
+		t.Error("expected a synthesis")
+	}
+}
+
+func TestBuildAggregationPromptChairmanPersona(t *testing.T) {
+	responses := []copilot.Response{{Model: "model-a", Content: "Answer A"}}
+	tests := []struct {
+		persona string
+		want    string
+	}{
+		{"", "Take a CLEAR, DECISIVE stance"},
+		{ChairmanDecisive, "Take a CLEAR, DECISIVE stance"},
+		{ChairmanBalanced, "Do NOT force a single answer"},
+		{ChairmanAcademic, "Distinguish established facts from interpretation"},
+		{ChairmanDevilsAdvocate, "Then argue AGAINST it"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.persona, func(t *testing.T) {
+			c := NewCouncilWithClient(Config{ChairmanPersona: tt.persona}, copilot.NewMockClient(nil, false))
+			prompt := c.buildAggregationPrompt("q", responses, nil)
+			if !strings.Contains(prompt, tt.want) {
+				t.Errorf("prompt for %q persona is missing %q:\n%s", tt.persona, tt.want, prompt)
+			}
+			for persona, task := range chairmanInstructions {
+				if persona != tt.persona && !(tt.persona == "" && persona == ChairmanDecisive) && strings.Contains(prompt, task) {
+					t.Errorf("prompt for %q persona also contains the %q instructions", tt.persona, persona)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildPromptsNeutralFraming(t *testing.T) {
+	responses := []copilot.Response{{Model: "model-a", Content: "Answer A"}, {Model: "model-b", Content: "Answer B"}}
+	reviews := []Review{{ReviewerModel: "model-a", Rankings: []Ranking{{Model: "model-b", Rank: 1, Reasoning: "1. Response A: fine"}}}}
+
+	for _, persona := range ChairmanPersonas {
+		c := NewCouncilWithClient(Config{
+			Framing:           FramingNeutral,
+			ChairmanPersona:   persona,
+			AggregatorContext: "Prefer the standard library.",
+			CollectSources:    true,
+		}, copilot.NewMockClient(nil, false))
+
+		prompt := c.buildAggregationPrompt("q", responses, reviews)
+		for _, want := range []string{"You are a synthesizer.", "## Candidate Answers:", "## Evaluations:", "candidate answers AND their evaluations"} {
+			if !strings.Contains(prompt, want) {
+				t.Errorf("%s: neutral aggregation prompt is missing %q:\n%s", persona, want, prompt)
+			}
+		}
+		if lower := strings.ToLower(prompt); strings.Contains(lower, "council") || strings.Contains(lower, "chairman") {
+			t.Errorf("%s: neutral aggregation prompt still uses council terms:\n%s", persona, prompt)
+		}
+	}
+
+	c := NewCouncilWithClient(Config{Framing: FramingNeutral}, copilot.NewMockClient(nil, false))
+	prompt := c.buildReviewPrompt("q", responses)
+	for _, want := range []string{"You are an evaluator.", "2 different candidate answers", "Rank the candidate answers", "## Response A:", "1. Response [X]"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("neutral review prompt is missing %q:\n%s", want, prompt)
+		}
+	}
+
+	// The council framing stays the default
+	c = NewCouncilWithClient(Config{}, copilot.NewMockClient(nil, false))
+	if prompt := c.buildAggregationPrompt("q", responses, reviews); !strings.Contains(prompt, "You are the Chairman of an AI Council.") || !strings.Contains(prompt, "## Council Members' Responses:") {
+		t.Errorf("expected the council framing by default:\n%s", prompt)
+	}
+	if prompt := c.buildReviewPrompt("q", responses); !strings.Contains(prompt, "You are an expert evaluator. Below are 2 different responses") {
+		t.Errorf("expected the council framing by default:\n%s", prompt)
+	}
+}
+
+func TestTrimToWords(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		maxWords    int
+		want        string
+		wantTrimmed bool
+	}{
+		{"under cap", "One two three.", 5, "One two three.", false},
+		{"no cap", "One two three.", 0, "One two three.", false},
+		{"sentence boundary", "First point here. Second point is longer than allowed.", 6, "First point here.", true},
+		{"keeps paragraphs", "Use Go.\n\nIt is fast! And simple to deploy anywhere.", 5, "Use Go.\n\nIt is fast!", true},
+		{"no boundary", "a b c d e f", 3, "a b c…", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, trimmed := trimToWords(tt.text, tt.maxWords)
+			if got != tt.want || trimmed != tt.wantTrimmed {
+				t.Errorf("trimToWords() = %q, %v; want %q, %v", got, trimmed, tt.want, tt.wantTrimmed)
+			}
+		})
+	}
+}
+
+func TestExecuteAnswerLength(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"chairman": {{Content: "Short verdict. Then a long tail of extra detail nobody asked for."}},
+	}}
+	c := NewCouncilWithClient(Config{
+		Models:         []string{"model-a", "model-b"},
+		Aggregator:     "chairman",
+		Timeout:        time.Minute,
+		AnswerLength:   LengthShort,
+		MaxAnswerWords: 5,
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if !strings.Contains(result.AggregationPrompt, lengthInstructions[LengthShort]) || !strings.Contains(result.AggregationPrompt, "must not exceed 5 words") {
+		t.Errorf("expected the length constraint in the prompt:\n%s", result.AggregationPrompt)
+	}
+	if result.AggregatedResponse != "Short verdict." || !result.AnswerTrimmed {
+		t.Errorf("expected the answer trimmed to its first sentence, got %q (trimmed %v)", result.AggregatedResponse, result.AnswerTrimmed)
+	}
+	if result.AnswerLength != "short, max 5 words" {
+		t.Errorf("AnswerLength = %q, want the recorded target", result.AnswerLength)
+	}
+}
+
+func TestExecuteNoReviewFrom(t *testing.T) {
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b", "model-c"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		NoReviewFrom: []string{"model-c"},
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	if len(result.Abstained) != 1 || result.Abstained[0] != "model-c" {
+		t.Errorf("Abstained = %v, want [model-c]", result.Abstained)
+	}
+	if len(result.Reviews) != 2 {
+		t.Errorf("expected reviews from model-a and model-b only, got %d", len(result.Reviews))
+	}
+	rankedC := 0
+	for _, review := range result.Reviews {
+		if review.ReviewerModel == "model-c" {
+			t.Errorf("model-c should not review, got %+v", review)
+		}
+		for _, ranking := range review.Rankings {
+			if ranking.Model == "model-c" {
+				rankedC++
+			}
+		}
+	}
+	if rankedC != 2 || result.ReviewCoverage["model-c"] != 2 {
+		t.Errorf("model-c should still be ranked by both others: %d rankings, coverage %d", rankedC, result.ReviewCoverage["model-c"])
+	}
+	if !strings.Contains(result.AggregationPrompt, "mock answer from model-c") {
+		t.Error("model-c's answer should still be aggregated")
+	}
+}
+
+func TestExecuteReviewerMinWords(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-c": {{Content: "Sure!"}},
+		"model-d": {{Content: "I can't help with that request, but a search engine will find plenty."}},
+	}}
+	c := NewCouncilWithClient(Config{
+		Models:           []string{"model-a", "model-b", "model-c", "model-d"},
+		Aggregator:       "chairman",
+		Timeout:          time.Minute,
+		ReviewerMinWords: 5,
+		DetectRefusals:   true,
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if strings.Join(result.GatedReviewers, ",") != "model-c,model-d" {
+		t.Errorf("GatedReviewers = %v, want [model-c model-d]", result.GatedReviewers)
+	}
+	if strings.Join(result.Reviewers, ",") != "model-a,model-b" {
+		t.Errorf("expected only the substantial answers to review, got %v", result.Reviewers)
+	}
+	for _, review := range result.Reviews {
+		if review.ReviewerModel == "model-c" || review.ReviewerModel == "model-d" {
+			t.Errorf("gated members should not review, got %+v", review)
+		}
+	}
+	if result.ReviewCoverage["model-c"] != 2 || result.ReviewCoverage["model-d"] != 2 {
+		t.Errorf("gated members should still be reviewed by both others, coverage %v", result.ReviewCoverage)
+	}
+
+	// Nobody answered substantially: rather than no review at all, everyone reviews
+	c = NewCouncilWithClient(Config{
+		Models:           []string{"model-a", "model-b"},
+		Aggregator:       "chairman",
+		Timeout:          time.Minute,
+		ReviewerMinWords: 100,
+	}, copilot.NewMockClient(fixture, false))
+	result = c.Execute(context.Background(), "q", nil, nil)
+	if len(result.GatedReviewers) != 0 || len(result.Reviews) != 2 {
+		t.Errorf("expected everyone to review when nobody passes the gate, gated %v with %d reviews", result.GatedReviewers, len(result.Reviews))
+	}
+
+	// Without the gate, trivial answers review too
+	c = NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(fixture, false))
+	result = c.Execute(context.Background(), "q", nil, nil)
+	if len(result.GatedReviewers) != 0 || len(result.Reviewers) != 3 {
+		t.Errorf("expected every member to review without ReviewerMinWords, got reviewers %v", result.Reviewers)
+	}
+}
+
+func TestExecuteRetriesAggregationOnContextLength(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"chairman": {
+			{Error: "prompt is too long for this model"},
+			{Content: "Synthesis from a smaller prompt"},
+		},
+	}}
+	var prompts []string
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseAggregation {
+				prompts = append(prompts, prompt)
+			}
+		},
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("expected the reduced retry to succeed, got %v", result.Error)
+	}
+	if result.AggregatedResponse != "Synthesis from a smaller prompt" {
+		t.Errorf("unexpected answer %q", result.AggregatedResponse)
+	}
+	if len(prompts) != 2 || !strings.Contains(prompts[0], "Peer Review Results") || strings.Contains(prompts[1], "Peer Review Results") {
+		t.Errorf("expected a retry without the review details, got %d prompts", len(prompts))
+	}
+	if len(result.Reductions) != 1 || result.Reductions[0] != "peer review details" || result.AggregationPrompt != prompts[1] {
+		t.Errorf("Reductions = %v, want the review details recorded", result.Reductions)
+	}
+	if result.AggregationRetries != 1 {
+		t.Errorf("AggregationRetries = %d, want 1", result.AggregationRetries)
+	}
+}
+
+func TestExecuteShrinksAggregationToContextWindow(t *testing.T) {
+	run := func(windows map[string]int) (Result, int) {
+		calls := 0
+		c := NewCouncilWithClient(Config{
+			Models:         []string{"model-a", "model-b", "model-c"},
+			Aggregator:     "chairman",
+			Timeout:        time.Minute,
+			ContextWindows: windows,
+			TracePrompt: func(stage, model, prompt string) {
+				if stage == PhaseAggregation {
+					calls++
+				}
+			},
+		}, copilot.NewMockClient(nil, false))
+		return c.Execute(context.Background(), "q", nil, nil), calls
+	}
+
+	full, _ := run(nil)
+	if full.ContextWindow != 0 || full.PromptTokens != 0 {
+		t.Errorf("expected no estimate without a known window, got %d/%d", full.PromptTokens, full.ContextWindow)
+	}
+
+	// Just too small for the full prompt: the review details go before sending
+	window := estimateTokens(full.AggregationPrompt) - 1
+	result, calls := run(map[string]int{"chairman": window})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if calls != 1 || result.AggregationRetries != 0 {
+		t.Errorf("expected the prompt shrunk before the only request, got %d requests", calls)
+	}
+	if len(result.Reductions) != 1 || result.Reductions[0] != "peer review details" {
+		t.Errorf("Reductions = %v, want the review details dropped", result.Reductions)
+	}
+	if result.ContextWindow != window || result.PromptTokens > window || result.PromptTokens != estimateTokens(result.AggregationPrompt) {
+		t.Errorf("expected the estimate to fit the window: %d/%d", result.PromptTokens, result.ContextWindow)
+	}
+
+	// Far too small: everything that can go goes, and the estimate shows the overflow
+	result, _ = run(map[string]int{"chairman": 10})
+	if len(result.Reductions) != 3 || result.PromptTokens <= 10 {
+		t.Errorf("expected reviews and two responses dropped with the prompt still too long, got %v (%d tokens)", result.Reductions, result.PromptTokens)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want int
+	}{{"", 0}, {"abc", 1}, {"abcd", 1}, {"abcde", 2}, {"日本語です", 2}} {
+		if got := estimateTokens(tt.text); got != tt.want {
+			t.Errorf("estimateTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestDropLowestRanked(t *testing.T) {
+	responses := []copilot.Response{{Model: "a", Content: "A"}, {Model: "b", Content: "B"}, {Model: "c", Content: "C"}}
+	consensus := []ConsensusScore{{Model: "b"}, {Model: "a"}, {Model: "c"}}
+
+	kept, dropped := dropLowestRanked(responses, consensus)
+	if dropped.Model != "c" || len(kept) != 2 {
+		t.Errorf("expected the last-ranked response dropped, got %q", dropped.Model)
+	}
+
+	responses[0].Error = errors.New("timeout")
+	if _, dropped := dropLowestRanked(responses, consensus); dropped.Model != "a" {
+		t.Errorf("expected the failed response dropped first, got %q", dropped.Model)
+	}
+}
+
+func TestExecuteCompareStrategies(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a":  {{Content: "Answer A"}, {Content: "Ranking:\n1. Response A: better\n2. Response B: worse"}},
+		"model-b":  {{Content: "Answer B"}, {Content: "Ranking:\n1. Response A: better\n2. Response B: worse"}},
+		"chairman": {{Content: "Synthesis"}, {Content: "Response 2 is the best.\nCHOICE: 2"}},
+	}}
+	c := NewCouncilWithClient(Config{
+		Models:            []string{"model-a", "model-b"},
+		Aggregator:        "chairman",
+		Timeout:           time.Minute,
+		CompareStrategies: true,
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "q", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Strategies) != len(Strategies) {
+		t.Fatalf("expected one answer per strategy, got %+v", result.Strategies)
+	}
+
+	want := map[string]string{StrategyLLM: "Synthesis", StrategyVote: "Answer A", StrategyJudge: "Answer B"}
+	for _, agg := range result.Strategies {
+		if agg.Err != nil || agg.Answer != want[agg.Strategy] {
+			t.Errorf("%s strategy = %q (err %v), want %q", agg.Strategy, agg.Answer, agg.Err, want[agg.Strategy])
+		}
+	}
+	if result.AggregatedResponse != "Synthesis" {
+		t.Errorf("the chairman's synthesis should stay the final answer, got %q", result.AggregatedResponse)
+	}
+}
+
+func TestParseJudgeChoice(t *testing.T) {
+	tests := []struct {
+		reply string
+		n     int
+		want  int
+		ok    bool
+	}{
+		{"CHOICE: 2", 3, 1, true},
+		{"Response 3 covers more.\n**CHOICE:** 3", 3, 2, true},
+		{"choice: Response 1.", 3, 0, true},
+		{"CHOICE: 1\nOn reflection:\nCHOICE: 2", 3, 1, true},
+		{"Of the 7 points, response 1 wins", 3, 0, false}, // No CHOICE line
+		{"2", 3, 0, false},
+		{"CHOICE: 4", 3, 0, false},
+		{"CHOICE: 2 or 3", 3, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseJudgeChoice(tt.reply, tt.n)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseJudgeChoice(%q, %d) = %d, %v; want %d, %v", tt.reply, tt.n, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// mapCache is an in-memory AggregationCache
+type mapCache map[string]string
+
+func (m mapCache) Load(key string) (string, bool) {
+	answer, ok := m[key]
+	return answer, ok
+}
+
+func (m mapCache) Store(key, answer string) error {
+	m[key] = answer
+	return nil
+}
+
+func TestExecuteAggregationCache(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"chairman": {{Content: "First synthesis"}, {Content: "Second synthesis"}},
+	}}
+	cache := mapCache{}
+	c := NewCouncilWithClient(Config{
+		Models:           []string{"model-a", "model-b"},
+		Aggregator:       "chairman",
+		Timeout:          time.Minute,
+		AggregationCache: cache,
+	}, copilot.NewMockClient(fixture, false))
+
+	first := c.Execute(context.Background(), "q", nil, nil)
+	if first.Error != nil || first.AggregationCached || len(cache) != 1 {
+		t.Fatalf("expected a fresh synthesis to be stored, got cached=%v entries=%d err=%v", first.AggregationCached, len(cache), first.Error)
+	}
+
+	second := c.Execute(context.Background(), "q", nil, nil)
+	if !second.AggregationCached || second.AggregatedResponse != "First synthesis" {
+		t.Errorf("expected the cached synthesis for identical input, got cached=%v %q", second.AggregationCached, second.AggregatedResponse)
+	}
+
+	other := c.Execute(context.Background(), "another question", nil, nil)
+	if other.AggregationCached || other.AggregatedResponse != "Second synthesis" {
+		t.Errorf("expected a different question to miss the cache, got cached=%v %q", other.AggregationCached, other.AggregatedResponse)
+	}
+}
+
+func TestExecuteAggregationCacheKey(t *testing.T) {
+	full := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(nil, false)).Execute(context.Background(), "q", nil, nil)
+
+	// The key describes the prompt once shrunk to the context window
+	cache := mapCache{}
+	result := NewCouncilWithClient(Config{
+		Models:           []string{"model-a", "model-b"},
+		Aggregator:       "chairman",
+		Timeout:          time.Minute,
+		ContextWindows:   map[string]int{"chairman": estimateTokens(full.AggregationPrompt) - 1},
+		AggregationCache: cache,
+	}, copilot.NewMockClient(nil, false)).Execute(context.Background(), "q", nil, nil)
+	if len(result.Reductions) != 1 {
+		t.Fatalf("expected the prompt shrunk, got reductions %v", result.Reductions)
+	}
+	if _, ok := cache[aggregationKey("chairman", result.AggregationPrompt)]; !ok || len(cache) != 1 {
+		t.Errorf("expected the synthesis stored under the prompt sent, got %d entries", len(cache))
+	}
+
+	// A map-reduce hit skips the group summaries
+	var mu sync.Mutex
+	mapped := 0
+	cache = mapCache{}
+	c := NewCouncilWithClient(Config{
+		Models:              []string{"model-a", "model-b", "model-c", "model-d", "model-e", "model-f"},
+		Aggregator:          "chairman",
+		Timeout:             time.Minute,
+		AggregationStrategy: AggregationMapReduce,
+		AggregationCache:    cache,
+		TracePrompt: func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			if stage == PhaseMap {
+				mapped++
+			}
+		},
+	}, copilot.NewMockClient(nil, false))
+	first := c.Execute(context.Background(), "q", nil, nil)
+	if first.AggregationCached || mapped != 2 {
+		t.Fatalf("expected the first run to summarize 2 groups, got cached=%v and %d", first.AggregationCached, mapped)
+	}
+	second := c.Execute(context.Background(), "q", nil, nil)
+	if !second.AggregationCached || mapped != 2 || len(second.GroupSummaries) != 0 {
+		t.Errorf("expected a cache hit without summaries, got cached=%v, %d summaries requested", second.AggregationCached, mapped)
+	}
+	if second.AggregatedResponse != first.AggregatedResponse {
+		t.Errorf("expected the cached synthesis, got %q", second.AggregatedResponse)
+	}
+}
+
+// syncCache is an in-memory ResponseCache safe for parallel model calls
+type syncCache struct {
+	mu      sync.Mutex
+	answers map[string]string
+}
+
+func (s *syncCache) Load(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	answer, ok := s.answers[key]
+	return answer, ok
+}
+
+func (s *syncCache) Store(key, answer string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.answers[key] = answer
+	return nil
+}
+
+func TestExecuteOffline(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a":  {{Content: "Recorded answer A"}},
+		"model-b":  {{Content: "Recorded answer B"}},
+		"chairman": {{Content: "Recorded synthesis"}},
+	}}
+	cache := &syncCache{answers: map[string]string{}}
+	config := Config{
+		Models:        []string{"model-a", "model-b"},
+		Aggregator:    "chairman",
+		Timeout:       time.Minute,
+		ResponseCache: cache,
+	}
+	recorded := NewCouncilWithClient(config, copilot.NewMockClient(fixture, false)).Execute(context.Background(), "q", nil, nil)
+	if recorded.Error != nil || recorded.AggregatedResponse != "Recorded synthesis" {
+		t.Fatalf("recording run failed: %q, %v", recorded.AggregatedResponse, recorded.Error)
+	}
+
+	// Offline, the client is never asked, so the replay must match the recording
+	config.Offline = true
+	replay := NewCouncilWithClient(config, nil).Execute(context.Background(), "q", nil, nil)
+	if replay.Error != nil || replay.AggregatedResponse != "Recorded synthesis" {
+		t.Fatalf("expected the recorded synthesis offline, got %q, %v", replay.AggregatedResponse, replay.Error)
+	}
+	for _, resp := range replay.ModelResponses {
+		if want := "Recorded answer " + strings.ToUpper(strings.TrimPrefix(resp.Model, "model-")); resp.Content != want {
+			t.Errorf("%s: expected %q offline, got %q", resp.Model, want, resp.Content)
+		}
+	}
+
+	// Another question was never recorded: fail at once, naming the members
+	missed := NewCouncilWithClient(config, nil).Execute(context.Background(), "another question", nil, nil)
+	if !errors.Is(missed.Error, ErrNotCached) {
+		t.Fatalf("expected ErrNotCached for an unrecorded question, got %v", missed.Error)
+	}
+	if !strings.Contains(missed.Error.Error(), "model-a, model-b") {
+		t.Errorf("expected the error to name the missing members, got %q", missed.Error)
+	}
+
+	// A member added since the recording is the only one missing
+	config.Models = append(config.Models, "model-c")
+	partial := NewCouncilWithClient(config, nil).Execute(context.Background(), "q", nil, nil)
+	if !errors.Is(partial.Error, ErrNotCached) || strings.Contains(partial.Error.Error(), "model-a") || !strings.Contains(partial.Error.Error(), "model-c") {
+		t.Errorf("expected only model-c to be reported missing, got %v", partial.Error)
+	}
+}
+
+func TestExecuteMulti(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"chairman": {{Content: "Answer one"}, {Content: "Answer two"}, {Content: "Merged document"}},
+	}}
+	var prompts []string
+	var questions []string
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseMeta {
+				prompts = append(prompts, prompt)
+			}
+		},
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.ExecuteMulti(context.Background(), []string{"first?", "second?"}, true, func(event Event) {
+		if event.Type == EventPhaseStart && event.Phase == PhaseQuestion {
+			questions = append(questions, fmt.Sprintf("%d:%s", event.Count, event.Prompt))
+		}
+	})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.SubResults) != 2 || result.SubResults[1].AggregatedResponse != "Answer two" {
+		t.Fatalf("expected one sub-result per question, got %d", len(result.SubResults))
+	}
+	if result.AggregatedResponse != "Merged document" || result.AggregatorModel != "chairman" {
+		t.Errorf("unexpected meta answer %q from %q", result.AggregatedResponse, result.AggregatorModel)
+	}
+	if len(prompts) != 1 || !strings.Contains(prompts[0], "## Part 2: second?\n\nAnswer two") {
+		t.Errorf("expected the meta prompt to hold every answer, got %q", prompts)
+	}
+	if strings.Join(questions, ",") != "1:first?,2:second?" {
+		t.Errorf("unexpected question events %v", questions)
+	}
+}
+
+func TestExecuteMultiWithoutMeta(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"chairman": {{Content: "Answer one"}, {Error: "internal server error"}},
+	}}
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.ExecuteMulti(context.Background(), []string{"first?", "second?"}, false, nil)
+	if result.Error != nil {
+		t.Fatalf("one answered question should be enough, got %v", result.Error)
+	}
+	if result.SubResults[1].Error == nil {
+		t.Error("expected the second question to fail")
+	}
+	if result.AggregatedResponse != "## first?\n\nAnswer one" {
+		t.Errorf("expected only the answered question joined, got %q", result.AggregatedResponse)
+	}
+}
+
+func TestExecuteMapReduce(t *testing.T) {
+	models := []string{"model-a", "model-b", "model-c", "model-d", "model-e", "model-f"}
+	var mu sync.Mutex
+	traced := make(map[string]int)
+	c := NewCouncilWithClient(Config{
+		Models:              models,
+		Aggregator:          "chairman",
+		Timeout:             time.Minute,
+		AggregationStrategy: AggregationMapReduce,
+		TracePrompt: func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			traced[stage]++
+		},
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.GroupSummaries) != 2 || traced[PhaseMap] != 2 {
+		t.Fatalf("expected 2 group summaries, got %d (%d traced)", len(result.GroupSummaries), traced[PhaseMap])
+	}
+	if got := strings.Join(result.GroupSummaries[1].Members, ","); got != "model-e,model-f" {
+		t.Errorf("unexpected second group %s", got)
+	}
+	if !strings.Contains(result.AggregationPrompt, "## Group Summaries:") || strings.Contains(result.AggregationPrompt, "## Council Members' Responses:") {
+		t.Errorf("expected the chairman to synthesize the summaries:\n%s", result.AggregationPrompt)
+	}
+	if result.AggregatedResponse == "" {
+		t.Error("expected a final answer")
+	}
+
+	// Small councils fit one prompt
+	c = NewCouncilWithClient(Config{
+		Models:              models[:4],
+		Aggregator:          "chairman",
+		Timeout:             time.Minute,
+		AggregationStrategy: AggregationMapReduce,
+	}, copilot.NewMockClient(nil, false))
+	if result := c.Execute(context.Background(), "What is Go?", nil, nil); len(result.GroupSummaries) != 0 {
+		t.Errorf("expected no group summaries for 4 members, got %d", len(result.GroupSummaries))
+	}
+}
+
+func TestBuildReducePromptFailedGroup(t *testing.T) {
+	c := NewCouncilWithClient(Config{}, copilot.NewMockClient(nil, false))
+	responses := []copilot.Response{{Model: "a", Content: "Answer A"}, {Model: "b", Content: "Answer B"}, {Model: "c", Content: "Answer C"}}
+	prompt := c.buildReducePrompt("q", responses, []GroupSummary{
+		{Members: []string{"a", "b"}, Summary: "A and B agree"},
+		{Members: []string{"c"}, Err: errors.New("boom")},
+	})
+	for _, want := range []string{"3 AI models", "### Group 1 - a, b:\nA and B agree", "#### Response from c:\nAnswer C"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected %q in:\n%s", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "Answer A") {
+		t.Error("summarized responses should not be repeated")
+	}
+}
+
+func TestExtractCitations(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "markdown link",
+			content: "See [the spec](https://go.dev/ref/spec) for details.",
+			want:    []string{"https://go.dev/ref/spec"},
+		},
+		{
+			name:    "autolink and bare URL with trailing punctuation",
+			content: "Docs: <https://pkg.go.dev/context>. Also https://go.dev/blog/pipelines, and (https://go.dev/doc/effective_go).",
+			want:    []string{"https://pkg.go.dev/context", "https://go.dev/blog/pipelines", "https://go.dev/doc/effective_go"},
+		},
+		{
+			name:    "balanced parentheses are part of the URL",
+			content: "[Go](https://en.wikipedia.org/wiki/Go_(programming_language)) is compiled.",
+			want:    []string{"https://en.wikipedia.org/wiki/Go_(programming_language)"},
+		},
+		{
+			name:    "emphasis and query strings",
+			content: "**https://example.com/search?q=go&lang=en** is useful",
+			want:    []string{"https://example.com/search?q=go&lang=en"},
+		},
+		{
+			name:    "DOIs and arXiv IDs",
+			content: "Lamport (doi:10.1145/359545.359563) and https://doi.org/10.1145/359545.359563; see also 10.1145/359545.359563 and arXiv: 1706.03762v5.",
+			want:    []string{"doi:10.1145/359545.359563", "https://doi.org/10.1145/359545.359563", "arXiv:1706.03762v5"},
+		},
+		{
+			name:    "repeats are listed once",
+			content: "https://go.dev/ and again https://go.dev/.",
+			want:    []string{"https://go.dev/"},
+		},
+		{
+			name:    "no sources",
+			content: "Use channels; version 1.22 fixed it.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractCitations(tt.content); strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("extractCitations() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteCollectSources(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a":  {{Content: "Use contexts, see https://pkg.go.dev/context."}},
+			"model-b":  {{Content: "Per [the blog](https://go.dev/blog/context) and https://pkg.go.dev/context, cancel early."}},
+			"chairman": {{Content: "Cancel early with contexts."}},
+		},
+	}
+	for _, collect := range []bool{false, true} {
+		var mu sync.Mutex
+		var aggregationPrompt string
+		c := NewCouncilWithClient(Config{
+			Models:         []string{"model-a", "model-b"},
+			Aggregator:     "chairman",
+			Timeout:        time.Minute,
+			CollectSources: collect,
+			TracePrompt: func(stage, model, prompt string) {
+				mu.Lock()
+				defer mu.Unlock()
+				if stage == PhaseAggregation {
+					aggregationPrompt = prompt
+				}
+			},
+		}, copilot.NewMockClient(fixture, false))
+
+		result := c.Execute(context.Background(), "How do I cancel work in Go?", nil, nil)
+		if result.Error != nil {
+			t.Fatalf("collect=%v: unexpected error: %v", collect, result.Error)
+		}
+		if got := strings.Contains(aggregationPrompt, citeInstruction); got != collect {
+			t.Errorf("collect=%v: cite instruction in the aggregation prompt = %v", collect, got)
+		}
+		if !collect {
+			if result.AggregatedResponse != "Cancel early with contexts." || result.Sources != nil {
+				t.Errorf("sources should not be collected without CollectSources, got %q and %q", result.AggregatedResponse, result.Sources)
+			}
+			continue
+		}
+
+		want := []string{"https://pkg.go.dev/context", "https://go.dev/blog/context"}
+		if strings.Join(result.Sources, " ") != strings.Join(want, " ") {
+			t.Errorf("Sources = %q, want %q", result.Sources, want)
+		}
+		if got := strings.Join(result.ModelResponses[1].Citations, " "); got != "https://go.dev/blog/context https://pkg.go.dev/context" {
+			t.Errorf("model-b citations = %s", got)
+		}
+		wantAnswer := "Cancel early with contexts.\n\n## Sources\n\n1. https://pkg.go.dev/context\n2. https://go.dev/blog/context"
+		if result.AggregatedResponse != wantAnswer {
+			t.Errorf("AggregatedResponse = %q, want %q", result.AggregatedResponse, wantAnswer)
+		}
+	}
+}
+
+func TestExecuteMinDistinct(t *testing.T) {
+	echo := "Use channels to communicate between goroutines and avoid shared memory."
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a": {{Content: echo}},
+		"model-b": {{Content: echo}},
+		"echo":    {{Content: echo}},
+		"mutex":   {{Content: "Prefer a mutex when protecting a small piece of shared state."}},
+		"atomic":  {{Content: "Reach for sync/atomic counters if all you need is a number."}},
+	}}
+	tests := []struct {
+		name          string
+		minDistinct   int
+		pool          []string
+		wantDistinct  int
+		wantAdded     []string
+		wantResponses int
+	}{
+		{name: "already distinct enough", minDistinct: 1, pool: []string{"mutex"}, wantDistinct: 1, wantResponses: 2},
+		{name: "adds until distinct", minDistinct: 2, pool: []string{"model-a", "chairman", "echo", "mutex", "atomic"}, wantDistinct: 2, wantAdded: []string{"echo", "mutex"}, wantResponses: 4},
+		{name: "pool runs out", minDistinct: 4, pool: []string{"mutex", "atomic"}, wantDistinct: 3, wantAdded: []string{"mutex", "atomic"}, wantResponses: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCouncilWithClient(Config{
+				Models:       []string{"model-a", "model-b"},
+				Aggregator:   "chairman",
+				Timeout:      time.Minute,
+				QueryOnly:    true,
+				MinDistinct:  tt.minDistinct,
+				DistinctPool: tt.pool,
+			}, copilot.NewMockClient(fixture, false))
+
+			result := c.Execute(context.Background(), "How do I share state?", nil, nil)
+			if result.Error != nil {
+				t.Fatalf("unexpected error: %v", result.Error)
+			}
+			if result.DistinctViewpoints != tt.wantDistinct {
+				t.Errorf("expected %d distinct viewpoints, got %d", tt.wantDistinct, result.DistinctViewpoints)
+			}
+			if strings.Join(result.AddedMembers, ",") != strings.Join(tt.wantAdded, ",") {
+				t.Errorf("expected %v added, got %v", tt.wantAdded, result.AddedMembers)
+			}
+			if len(result.ModelResponses) != tt.wantResponses {
+				t.Errorf("expected %d responses, got %d", tt.wantResponses, len(result.ModelResponses))
+			}
+		})
+	}
+}
+
+func TestExecuteMinResponseLength(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"short":   {{Content: "Sure!"}},
+			"exact":   {{Content: "Use a mutex"}},
+			"verbose": {{Content: "Guard the map with a sync.Mutex."}},
+		},
+	}
+	tests := []struct {
+		name       string
+		minLength  int
+		words      bool
+		wantFailed []string
+	}{
+		{name: "disabled by default"},
+		{name: "one below the minimum fails", minLength: 6, wantFailed: []string{"short"}},
+		{name: "exactly the minimum passes", minLength: 11, wantFailed: []string{"short"}},
+		{name: "one above the minimum", minLength: 12, wantFailed: []string{"short", "exact"}},
+		{name: "words", minLength: 4, words: true, wantFailed: []string{"short", "exact"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCouncilWithClient(Config{
+				Models:            []string{"short", "exact", "verbose"},
+				Aggregator:        "chairman",
+				Timeout:           time.Minute,
+				QueryOnly:         true,
+				MinResponseLength: tt.minLength,
+				MinResponseWords:  tt.words,
+			}, copilot.NewMockClient(fixture, false))
+
+			result := c.Execute(context.Background(), "How do I share a map?", nil, nil)
+			if result.Error != nil {
+				t.Fatalf("unexpected error: %v", result.Error)
+			}
+			var failed []string
+			for _, resp := range result.ModelResponses {
+				if resp.Error == nil {
+					continue
+				}
+				if !errors.Is(resp.Error, ErrInsufficientContent) {
+					t.Errorf("%s: error = %v, want ErrInsufficientContent", resp.Model, resp.Error)
+				}
+				failed = append(failed, resp.Model)
+			}
+			if strings.Join(failed, ",") != strings.Join(tt.wantFailed, ",") {
+				t.Errorf("failed = %v, want %v", failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestExecuteMinReviewLength(t *testing.T) {
+	fixture := &copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a":  {{Content: "Yes."}},
+			"model-b":  {{Content: "Yes, use a mutex."}},
+			"chairman": {{Content: "Yes."}},
+		},
+	}
+	tests := []struct {
+		name       string
+		minLength  int
+		words      bool
+		wantReview bool
+		wantReason string
+	}{
+		{name: "disabled by default", wantReview: true},
+		{name: "one answer long enough", minLength: 17, wantReview: true},
+		{name: "all answers too short", minLength: 18, wantReason: "all answers under 18 characters"},
+		{name: "words", minLength: 5, words: true, wantReason: "all answers under 5 words"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCouncilWithClient(Config{
+				Models:          []string{"model-a", "model-b"},
+				Aggregator:      "chairman",
+				Timeout:         time.Minute,
+				MinReviewLength: tt.minLength,
+				MinReviewWords:  tt.words,
+			}, copilot.NewMockClient(fixture, false))
+
+			var reviewStarted bool
+			result := c.ExecuteWithEvents(context.Background(), "Can I share a map?", func(event Event) {
+				if event.Type == EventPhaseStart && event.Phase == PhaseReview {
+					reviewStarted = true
+				}
+			})
+			if result.Error != nil || result.AggregatedResponse == "" {
+				t.Fatalf("the run should still be aggregated: %v", result.Error)
+			}
+			if (len(result.Reviews) > 0) != tt.wantReview || result.ReviewSkipped != tt.wantReason {
+				t.Errorf("got %d reviews, skipped %q; want review %v, skipped %q", len(result.Reviews), result.ReviewSkipped, tt.wantReview, tt.wantReason)
+			}
+			if reviewStarted != tt.wantReview {
+				t.Errorf("review phase started = %v, want %v", reviewStarted, tt.wantReview)
+			}
+		})
+	}
+}
+
+func TestExecuteGatherMode(t *testing.T) {
+	for _, config := range []Config{
+		{Mode: ModeGather, Aggregator: "chairman"},
+		{Aggregator: NoAggregator},
+	} {
+		var mu sync.Mutex
+		var stages []string
+		config.Models = []string{"model-a", "model-b", "model-c"}
+		config.Timeout = time.Minute
+		config.RefineRounds = 1
+		config.CompareStrategies = true
+		config.TracePrompt = func(stage, model, prompt string) {
+			mu.Lock()
+			defer mu.Unlock()
+			stages = append(stages, stage)
+		}
+		c := NewCouncilWithClient(config, copilot.NewMockClient(nil, false))
+
+		result := c.Execute(context.Background(), "What is Go?", nil, nil)
+		if result.Error != nil {
+			t.Fatalf("aggregator %q: unexpected error: %v", config.Aggregator, result.Error)
+		}
+		if result.AggregatedResponse != "" || result.AggregationPrompt != "" || result.AggregatorModel != "" {
+			t.Errorf("aggregator %q: expected no synthesis, got %q from %q", config.Aggregator, result.AggregatedResponse, result.AggregatorModel)
+		}
+		if len(result.Consensus) != 3 || len(result.Rounds) != 0 || len(result.Strategies) != 0 {
+			t.Errorf("aggregator %q: expected the consensus only, got %d scores, %d rounds, %d strategies", config.Aggregator, len(result.Consensus), len(result.Rounds), len(result.Strategies))
+		}
+		for _, stage := range stages {
+			if stage != PhaseQuery && stage != PhaseReview {
+				t.Errorf("aggregator %q: unexpected %s prompt", config.Aggregator, stage)
+			}
+		}
+	}
+}
+
+func TestExecuteReuseResponses(t *testing.T) {
+	reused := []copilot.Response{
+		{Member: "model-a", Model: "model-a", Content: "Stored answer from model-a"},
+		{Member: "model-b", Model: "model-b", Content: "Stored answer from model-b"},
+	}
+	var queried []string
+	c := NewCouncilWithClient(Config{
+		Models:         []string{"model-a", "model-b"},
+		Aggregator:     "chairman",
+		Timeout:        time.Minute,
+		ReuseResponses: reused,
+		TracePrompt: func(stage, model, prompt string) {
+			if stage == PhaseQuery {
+				queried = append(queried, model)
+			}
+		},
+	}, copilot.NewMockClient(nil, false))
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if !result.ResponsesReused || len(queried) != 0 {
+		t.Errorf("expected the stored answers to stand in for the query, reused=%v, queried %q", result.ResponsesReused, queried)
+	}
+	if len(result.ModelResponses) != 2 || result.ModelResponses[1].Content != "Stored answer from model-b" {
+		t.Errorf("unexpected responses: %+v", result.ModelResponses)
+	}
+	if len(result.Reviews) != 2 || result.AggregatedResponse == "" {
+		t.Errorf("expected peer review and aggregation to still run, got %d reviews and %q", len(result.Reviews), result.AggregatedResponse)
+	}
+}
+
+func TestStats(t *testing.T) {
+	stats := Stats(Result{
+		ModelResponses: []copilot.Response{
+			{Model: "model-a", Content: "a", Duration: 2 * time.Second},
+			{Member: "critic", Model: "model-b", Content: "b", Duration: time.Second},
+			{Model: "model-c", Error: errors.New("boom"), Duration: 3 * time.Second},
+			{Model: "model-d", Duration: 500 * time.Millisecond}, // Empty answer
+		},
+		Reviews: []Review{
+			{ReviewerModel: "model-a"},
+			{ReviewerModel: "critic", Error: errors.New("timeout")},
+			{ReviewerModel: "model-d", Skipped: true},
+		},
+	})
+
+	if stats.Query != (PhaseStats{Total: 4, Succeeded: 2, Failed: 2}) {
+		t.Errorf("query = %+v", stats.Query)
+	}
+	if stats.Review != (PhaseStats{Total: 2, Succeeded: 1, Failed: 1}) {
+		t.Errorf("expected the skipped review not counted, got %+v", stats.Review)
+	}
+	if stats.Critique.Total != 0 || stats.AggregationSucceeded {
+		t.Errorf("expected no critiques and no aggregation, got %+v, %v", stats.Critique, stats.AggregationSucceeded)
+	}
+	if stats.Fastest != "critic" || stats.FastestDuration != time.Second || stats.QueryDuration != 3*time.Second {
+		t.Errorf("fastest %s in %s, phase %s", stats.Fastest, stats.FastestDuration, stats.QueryDuration)
+	}
+	if len(stats.Members) != 4 || !stats.Members[1].Succeeded || stats.Members[1].Member != "critic" || stats.Members[3].Succeeded {
+		t.Errorf("unexpected members: %+v", stats.Members)
+	}
+}
+
+func TestExecuteSingleSuccessFallback(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-b": {{Error: "internal server error"}},
+		"model-c": {{Error: "internal server error"}},
+	}}
+	var stages []string
+	config := Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		TracePrompt: func(stage, model, prompt string) {
+			stages = append(stages, stage)
+		},
+	}
+
+	result := NewCouncilWithClient(config, copilot.NewMockClient(fixture, false)).Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if result.AggregationSkipped != "single-model fallback" || result.AggregatorModel != "model-a" {
+		t.Errorf("expected the fallback to model-a, got %q from %q", result.AggregationSkipped, result.AggregatorModel)
+	}
+	if !strings.Contains(result.AggregatedResponse, "mock answer from model-a") || len(result.Reviews) != 0 {
+		t.Errorf("expected model-a's answer unreviewed, got %q and %d reviews", result.AggregatedResponse, len(result.Reviews))
+	}
+	if strings.Join(stages, ",") != PhaseQuery {
+		t.Errorf("expected only the query to run, got %v", stages)
+	}
+
+	// Turned off, the chairman still synthesizes the lone answer
+	config.AggregateSingleSuccess = true
+	stages = nil
+	result = NewCouncilWithClient(config, copilot.NewMockClient(fixture, false)).Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil || result.AggregationSkipped != "" || result.AggregatorModel != "chairman" {
+		t.Errorf("expected the chairman to answer, got %q from %q (%v)", result.AggregationSkipped, result.AggregatorModel, result.Error)
+	}
+}
+
+func TestVoteFields(t *testing.T) {
+	schema, err := parseOutputSchema(`{"type": "object", "properties": {"name": {"type": "string"}, "year": {"type": "integer"}, "tags": {"type": "array"}}, "required": ["name"]}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	merged, votes, violations := voteFields([]copilot.Response{
+		{Model: "model-a", Content: `{"name": "Go", "year": 2009, "tags": ["fast"]}`},
+		{Model: "model-b", Content: "```json\n{\"year\": 2009.0, \"name\": \"Go\", \"tags\": [\"simple\"]}\n```"},
+		{Model: "model-c", Content: `{"name": "Golang", "year": 2012}`},
+		{Model: "model-d", Content: `{"year": 2009}`},
+		{Model: "model-e", Content: `{"name": "Go", "year": "2009"}`},
+		{Model: "model-f", Content: "Go was released in 2009."},
+		{Model: "model-g", Error: errors.New("timeout")},
+	}, schema)
+
+	if want := "{\n  \"name\": \"Go\",\n  \"tags\": [\n    \"fast\"\n  ],\n  \"year\": 2009\n}"; merged != want {
+		t.Errorf("merged = %s, want %s", merged, want)
+	}
+	var got []string
+	for _, vote := range votes {
+		got = append(got, fmt.Sprintf("%s=%s %d/%d %v", vote.Field, vote.Value, vote.Votes, vote.Voters, vote.Tied))
+	}
+	if want := `name="Go" 2/3 false,tags=["fast"] 1/2 true,year=2009 2/3 false`; strings.Join(got, ",") != want {
+		t.Errorf("votes = %s, want %s", strings.Join(got, ","), want)
+	}
+	var flagged []string
+	for _, v := range violations {
+		flagged = append(flagged, v.Member+": "+v.Reason)
+	}
+	if want := `model-d: missing "name",model-e: "year" is not of type integer,model-f: not a JSON object`; strings.Join(flagged, ",") != want {
+		t.Errorf("violations = %s, want %s", strings.Join(flagged, ","), want)
+	}
+}
+
+func TestExecuteOutputSchema(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a": {{Content: `{"answer": "yes", "confidence": 0.9}`}},
+		"model-b": {{Content: `{"answer": "yes", "confidence": 0.7}`}},
+		"model-c": {{Content: "Yes, I think so."}},
+	}}
+	var prompts []string
+	c := NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b", "model-c"},
+		Aggregator:   "chairman",
+		Timeout:      time.Minute,
+		OutputSchema: `{"type": "object", "properties": {"answer": {"type": "string"}, "confidence": {"type": "number"}}}`,
+		TracePrompt: func(stage, model, prompt string) {
+			prompts = append(prompts, stage+": "+prompt)
+		},
+	}, copilot.NewMockClient(fixture, false))
+
+	result := c.Execute(context.Background(), "Is Go fast?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(prompts) != 1 || !strings.Contains(prompts[0], "matches this JSON Schema") || !strings.Contains(prompts[0], `"confidence"`) {
+		t.Errorf("expected only the query, with the schema in its prompt, got %q", prompts)
+	}
+	if want := "{\n  \"answer\": \"yes\",\n  \"confidence\": 0.9\n}"; result.AggregatedResponse != want {
+		t.Errorf("answer = %s, want %s", result.AggregatedResponse, want)
+	}
+	if len(result.NonConforming) != 1 || result.NonConforming[0].Member != "model-c" || len(result.Reviews) != 0 {
+		t.Errorf("expected model-c flagged and no reviews, got %+v and %d reviews", result.NonConforming, len(result.Reviews))
+	}
+
+	// Without a conforming answer there is nothing to vote on
+	fixture.Models["model-a"] = []copilot.MockResponse{{Content: "yes"}}
+	fixture.Models["model-b"] = []copilot.MockResponse{{Content: "[1, 2]"}}
+	result = NewCouncilWithClient(Config{
+		Models:       []string{"model-a", "model-b", "model-c"},
+		Timeout:      time.Minute,
+		OutputSchema: `{"properties": {"answer": {"type": "string"}}}`,
+	}, copilot.NewMockClient(fixture, false)).Execute(context.Background(), "Is Go fast?", nil, nil)
+	if result.Error == nil || len(result.NonConforming) != 3 {
+		t.Errorf("expected an error and every answer flagged, got %v, %+v", result.Error, result.NonConforming)
+	}
+}
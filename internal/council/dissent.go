@@ -0,0 +1,82 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// noDissent is the reply a member gives when it agrees with the final answer
+const noDissent = "NONE"
+
+// Dissent is a member's main disagreement with the final answer
+type Dissent struct {
+	Member string
+	Point  string
+}
+
+// collectDissents asks every member that answered in stage 1 for its key point
+// of disagreement with the final answer, in parallel. Members that agree, or
+// that fail to reply, are left out.
+func (c *Council) collectDissents(ctx context.Context, question, final string, responses []copilot.Response) []Dissent {
+	var (
+		wg       sync.WaitGroup
+		dissents = make([]Dissent, len(responses))
+	)
+	for i, resp := range responses {
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(idx int, resp copilot.Response) {
+			defer wg.Done()
+			// A panic leaves the member out, like any other failed reply
+			defer recoverPanic(func(error) {})
+
+			prompt := buildDissentPrompt(question, resp.Content, final)
+			c.tracePrompt(PhaseDissent, resp.Label(), prompt)
+			reply, _, err := c.client.AskSingleModel(ctx, c.member(resp.Label()), prompt, c.config.Timeout)
+			if err != nil {
+				return
+			}
+			if point := parseDissent(reply); point != "" {
+				dissents[idx] = Dissent{Member: resp.Label(), Point: point}
+			}
+		}(i, resp)
+	}
+	wg.Wait()
+
+	// Keep council order, dropping members without a dissent
+	kept := dissents[:0]
+	for _, dissent := range dissents {
+		if dissent.Member != "" {
+			kept = append(kept, dissent)
+		}
+	}
+	return kept
+}
+
+// buildDissentPrompt asks a member to compare its own answer with the final one
+func buildDissentPrompt(question, own, final string) string {
+	return fmt.Sprintf(`You are a member of an AI Council. The council was asked: "%s"
+
+## Your Answer:
+%s
+
+## The Council's Final Answer:
+%s
+
+In one or two sentences, state the most important point where you disagree with the final answer, or something important it leaves out. If you have no substantive disagreement, reply with exactly: %s`, question, own, final, noDissent)
+}
+
+// parseDissent normalizes a dissent reply; agreement yields ""
+func parseDissent(reply string) string {
+	reply = strings.TrimSpace(reply)
+	if strings.EqualFold(strings.Trim(reply, ".!* "), noDissent) {
+		return ""
+	}
+	return reply
+}
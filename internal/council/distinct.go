@@ -0,0 +1,96 @@
+package council
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// distinctThreshold is the similarity at which two answers count as the
+// same viewpoint for MinDistinct. It compares wording, not meaning, so it
+// sits well below unanimityThreshold to also catch lightly rephrased copies.
+const distinctThreshold = 0.5
+
+// distinctViewpoints clusters the answered responses by similarity and
+// returns how many clusters there are. An answer joins the first cluster
+// holding an answer at least distinctThreshold similar to it.
+func distinctViewpoints(responses []copilot.Response) int {
+	var clusters [][]string
+	for _, resp := range responses {
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		joined := false
+		for i, cluster := range clusters {
+			for _, answer := range cluster {
+				if textSimilarity(answer, resp.Content) >= distinctThreshold {
+					clusters[i] = append(clusters[i], resp.Content)
+					joined = true
+					break
+				}
+			}
+			if joined {
+				break
+			}
+		}
+		if !joined {
+			clusters = append(clusters, []string{resp.Content})
+		}
+	}
+	return len(clusters)
+}
+
+// ensureDistinct asks more models from the pool until the answers hold
+// MinDistinct viewpoints or the pool runs out. Each batch asks as many
+// models as viewpoints are missing; their answers join the council.
+func (c *Council) ensureDistinct(ctx context.Context, prompt string, result *Result, emit EventHandler) {
+	result.DistinctViewpoints = distinctViewpoints(result.ModelResponses)
+	pool := c.distinctPool(result.ModelResponses)
+	for result.DistinctViewpoints < c.config.MinDistinct && len(pool) > 0 && ctx.Err() == nil {
+		batch := pool[:min(c.config.MinDistinct-result.DistinctViewpoints, len(pool))]
+		pool = pool[len(batch):]
+
+		members := make([]copilot.Member, len(batch))
+		for i, model := range batch {
+			members[i] = c.modelMember(model)
+			emit(Event{Type: EventStarted, Phase: PhaseQuery, Member: members[i].Name, Model: members[i].Model})
+		}
+		c.tracePrompt(PhaseQuery, strings.Join(batch, ", "), prompt)
+		added := askWithResponses(ctx, c.client, members, prompt, c.config.Timeout, func(resp copilot.Response) {
+			emit(Event{Type: EventFinished, Phase: PhaseQuery, Member: resp.Label(), Model: resp.Model, Content: resp.Content, Duration: resp.Duration, TTFT: resp.TimeToFirstToken, Err: resp.Error})
+		})
+		markInsufficient(added, c.config.MinResponseLength, c.config.MinResponseWords)
+		if c.config.DetectRefusals {
+			markRefusals(added)
+		}
+		result.ModelResponses = append(result.ModelResponses, added...)
+		result.AddedMembers = append(result.AddedMembers, batch...)
+		result.DistinctViewpoints = distinctViewpoints(result.ModelResponses)
+	}
+}
+
+// distinctPool returns the models MinDistinct may add, in order: the
+// configured DistinctPool, or else every model the backend offers, without
+// the models already on the council and the aggregator
+func (c *Council) distinctPool(responses []copilot.Response) []string {
+	pool := c.config.DistinctPool
+	if len(pool) == 0 {
+		if lister, ok := c.backend().(interface{ ListModels() ([]string, error) }); ok {
+			pool, _ = lister.ListModels()
+		}
+	}
+
+	seated := map[string]bool{c.config.Aggregator: true}
+	for _, resp := range responses {
+		seated[resp.Model] = true
+	}
+	var candidates []string
+	for _, model := range pool {
+		if !seated[model] {
+			seated[model] = true
+			candidates = append(candidates, model)
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,113 @@
+package council
+
+import (
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// Phases of a council run, as reported in events and prompt traces
+const (
+	PhaseWarmup      = "warmup" // Sessions are created up front; Count is the number of members
+	PhaseQuery       = "query"
+	PhaseReview      = "review"
+	PhaseTiebreak    = "tiebreak"   // Config.Tiebreaker decides between the two responses tied at the top
+	PhaseRoundtable  = "roundtable" // Members revise their answers after reading the others'
+	PhaseCritique    = "critique"   // ModeProposer's critics respond to the proposal
+	PhaseMap         = "map"        // AggregationMapReduce summarizes groups of responses; Count is the number of groups
+	PhaseAggregation = "aggregation"
+	PhaseRefine      = "refine" // A refinement round begins; Count is the round number
+	PhaseDissent     = "dissent"
+	PhaseVerify      = "verify"   // The final answer's claims are checked against the responses
+	PhaseQuestion    = "question" // ExecuteMulti moves to its next question; Count is its number, Prompt the question
+	PhaseMeta        = "meta"     // ExecuteMulti merges the answers to all its questions
+)
+
+// EventType identifies what happened in an Event
+type EventType string
+
+// Every request to a model is bracketed by an EventStarted and an EventFinished
+// event; their Phase tells whether it was a query, a review, an aggregation, etc.
+const (
+	EventPhaseStart EventType = "phase_start"
+	EventStarted    EventType = "started"
+	EventFinished   EventType = "finished"
+	EventThrottled  EventType = "throttled" // The backend is rate limiting; Count is the new concurrency limit, Duration the backoff
+)
+
+// Event is one step of a council run
+type Event struct {
+	Type     EventType
+	Time     time.Time
+	Phase    string
+	Member   string        // Council member (or aggregator) the event is about
+	Model    string        // Model behind Member
+	Attempt  int           // 0 for the first request, n for the n-th retry
+	Count    int           // Participants in the phase, for EventPhaseStart; the group number in PhaseMap
+	Prompt   string        // Prompt shared by the phase, for EventPhaseStart of PhaseQuery
+	Content  string        // A member's answer, for EventFinished
+	Duration time.Duration // Request duration, for EventFinished
+	TTFT     time.Duration // Time to first token, for EventFinished of PhaseQuery
+	Err      error         // Request error, for EventFinished
+}
+
+// EventHandler receives the events of a council run
+type EventHandler func(Event)
+
+// FanOut returns a handler that passes every event to each of handlers in
+// turn, skipping nil ones
+func FanOut(handlers ...EventHandler) EventHandler {
+	return func(event Event) {
+		for _, handler := range handlers {
+			if handler != nil {
+				handler(event)
+			}
+		}
+	}
+}
+
+// timestamped wraps handler so that every event carries the time it was
+// emitted. A nil handler drops events.
+func timestamped(handler EventHandler) EventHandler {
+	return func(event Event) {
+		if handler == nil {
+			return
+		}
+		if event.Time.IsZero() {
+			event.Time = time.Now()
+		}
+		handler(event)
+	}
+}
+
+// progressEvents adapts the legacy progress and phase callbacks to an EventHandler.
+// progress keeps hearing about answers, retries, revisions and reviews under
+// the member names it always had: "model", "model (retry)", "model (revised)"
+// and "model (review)".
+func progressEvents(progress copilot.ProgressCallback, phase PhaseCallback) EventHandler {
+	if progress == nil && phase == nil {
+		return nil
+	}
+	return func(event Event) {
+		switch event.Type {
+		case EventPhaseStart:
+			if phase != nil && event.Phase == PhaseReview {
+				phase(event.Phase, event.Count)
+			}
+		case EventFinished:
+			if progress == nil {
+				return
+			}
+			switch {
+			case event.Attempt > 0:
+				progress(event.Member+" (retry)", event.Duration, event.Err)
+			case event.Phase == PhaseQuery:
+				progress(event.Member, event.Duration, event.Err)
+			case event.Phase == PhaseRoundtable:
+				progress(event.Member+" (revised)", event.Duration, event.Err)
+			case event.Phase == PhaseReview:
+				progress(event.Member+" (review)", event.Duration, event.Err)
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package council
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// ExecModel is a pseudo-model answered by an external command instead of
+// the Copilot SDK: the prompt is written to the command's stdin and its
+// stdout is the answer. Members whose model is Name are routed to it.
+type ExecModel struct {
+	Name    string
+	Command []string // argv; no shell is involved
+}
+
+// Ask runs the command once for prompt
+func (m ExecModel) Ask(ctx context.Context, prompt string, timeout time.Duration) (string, time.Duration, error) {
+	start := time.Now()
+	if len(m.Command) == 0 {
+		return "", 0, fmt.Errorf("exec model %s has no command", m.Name)
+	}
+
+	askCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(askCtx, m.Command[0], m.Command[1:]...)
+	cmd.Stdin = strings.NewReader(prompt)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	duration := time.Since(start)
+
+	switch {
+	case errors.Is(askCtx.Err(), context.DeadlineExceeded):
+		return "", duration, copilot.ErrTimeout
+	case err != nil:
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", duration, fmt.Errorf("%s: %w: %s", m.Command[0], err, msg)
+		}
+		return "", duration, fmt.Errorf("%s: %w", m.Command[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), duration, nil
+}
+
+// execClient routes requests for exec models to their commands and every
+// other request to the wrapped client
+type execClient struct {
+	ModelClient
+	models map[string]ExecModel
+}
+
+// withExecModels wraps client so that the given pseudo-models can sit on the
+// council next to SDK-backed ones
+func withExecModels(client ModelClient, models []ExecModel) ModelClient {
+	if len(models) == 0 {
+		return client
+	}
+	byName := make(map[string]ExecModel, len(models))
+	for _, m := range models {
+		byName[m.Name] = m
+	}
+	return &execClient{ModelClient: client, models: byName}
+}
+
+// AskMultipleModels asks the SDK-backed members through the wrapped client and
+// the exec models directly, all in parallel. Responses keep the members' order.
+func (e *execClient) AskMultipleModels(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, progress copilot.ProgressCallback) []copilot.Response {
+	return e.AskMultipleModelsWithResponses(ctx, members, question, timeout, copilot.ProgressResponses(progress))
+}
+
+// AskMultipleModelsWithResponses is AskMultipleModels, handing each response
+// to onResponse as soon as it completes
+func (e *execClient) AskMultipleModelsWithResponses(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response {
+	responses := make([]copilot.Response, len(members))
+	var sdkMembers []copilot.Member
+	var sdkIndexes []int
+	var wg sync.WaitGroup
+
+	for i, member := range members {
+		model, ok := e.models[member.Model]
+		if !ok {
+			sdkMembers = append(sdkMembers, member)
+			sdkIndexes = append(sdkIndexes, i)
+			continue
+		}
+
+		wg.Add(1)
+		go func(idx int, mbr copilot.Member) {
+			defer wg.Done()
+			resp := copilot.Response{Member: mbr.Name, Model: mbr.Model}
+			func() {
+				defer recoverPanic(func(err error) { resp.Error = err })
+				resp.Content, resp.Duration, resp.Error = model.Ask(ctx, question, timeout)
+			}()
+			responses[idx] = resp
+			if onResponse != nil {
+				onResponse(resp)
+			}
+		}(i, member)
+	}
+
+	if len(sdkMembers) > 0 {
+		for j, resp := range askWithResponses(ctx, e.ModelClient, sdkMembers, question, timeout, onResponse) {
+			responses[sdkIndexes[j]] = resp
+		}
+	}
+	wg.Wait()
+	return responses
+}
+
+// AskSingleModel asks an exec model directly, or else the wrapped client
+func (e *execClient) AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	if model, ok := e.models[member.Model]; ok {
+		return model.Ask(ctx, question, timeout)
+	}
+	return e.ModelClient.AskSingleModel(ctx, member, question, timeout)
+}
+
+// backend returns the client behind any response cache and exec-model
+// routing, which is the one that may support optional capabilities such as
+// session warmup
+func (c *Council) backend() ModelClient {
+	client := c.routed()
+	if routed, ok := client.(*execClient); ok {
+		return routed.ModelClient
+	}
+	return client
+}
+
+// routed returns the client behind any response cache
+func (c *Council) routed() ModelClient {
+	if cached, ok := c.client.(*cachingClient); ok {
+		return cached.ModelClient
+	}
+	return c.client
+}
+
+// isExecModel reports whether model is answered by an external command
+func (c *Council) isExecModel(model string) bool {
+	routed, ok := c.routed().(*execClient)
+	if !ok {
+		return false
+	}
+	_, ok = routed.models[model]
+	return ok
+}
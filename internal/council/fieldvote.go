@@ -0,0 +1,223 @@
+package council
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// FieldVote is the council's value for one field of an OutputSchema answer
+type FieldVote struct {
+	Field  string
+	Value  json.RawMessage // The value most members gave, as compact JSON
+	Votes  int             // Members that gave Value
+	Voters int             // Conforming members that gave the field at all
+	Tied   bool            // Another value had as many votes; the earliest member's won
+}
+
+// SchemaViolation is a member answer that did not match the OutputSchema
+type SchemaViolation struct {
+	Member string
+	Reason string
+}
+
+// outputSchema is the part of a JSON Schema that answers are checked
+// against: an object's properties, their types, and the required ones
+type outputSchema struct {
+	Properties map[string]struct {
+		Type json.RawMessage `json:"type"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+// ParseOutputSchema checks that schema is a JSON Schema for an object
+func ParseOutputSchema(schema string) error {
+	_, err := parseOutputSchema(schema)
+	return err
+}
+
+// parseOutputSchema reads the object schema that OutputSchema answers follow
+func parseOutputSchema(schema string) (outputSchema, error) {
+	var s outputSchema
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		return s, fmt.Errorf("invalid output schema: %w", err)
+	}
+	if len(s.Properties) == 0 {
+		return s, fmt.Errorf("invalid output schema: it must describe an object with properties")
+	}
+	return s, nil
+}
+
+// schemaInstruction asks the members to answer with a JSON object only
+func schemaInstruction(schema string) string {
+	return fmt.Sprintf("Answer with a single JSON object that matches this JSON Schema, and nothing else: no prose and no code fences.\n\n%s", strings.TrimSpace(schema))
+}
+
+// parseSchemaAnswer reads an answer as a JSON object and checks it against
+// the schema: required fields must be present, and typed fields of the
+// right type. Code fences around the object are tolerated.
+func parseSchemaAnswer(content string, schema outputSchema) (map[string]json.RawMessage, error) {
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "```") {
+		content = strings.TrimPrefix(content[strings.IndexByte(content+"\n", '\n'):], "\n")
+		content = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(content), "```"))
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content), &fields); err != nil || fields == nil {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+	for _, name := range schema.Required {
+		if _, ok := fields[name]; !ok {
+			return nil, fmt.Errorf("missing %q", name)
+		}
+	}
+	for name, value := range fields {
+		property, ok := schema.Properties[name]
+		if !ok || len(property.Type) == 0 {
+			continue
+		}
+		var types []string
+		if err := json.Unmarshal(property.Type, &types); err != nil {
+			var single string
+			_ = json.Unmarshal(property.Type, &single)
+			types = []string{single}
+		}
+		if !jsonTypeIn(value, types) {
+			return nil, fmt.Errorf("%q is not of type %s", name, strings.Join(types, " or "))
+		}
+	}
+	return fields, nil
+}
+
+// jsonTypeIn reports whether value has one of the JSON Schema types
+func jsonTypeIn(value json.RawMessage, types []string) bool {
+	var v any
+	if err := json.Unmarshal(value, &v); err != nil {
+		return false
+	}
+	for _, t := range types {
+		switch x := v.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case float64:
+			if t == "number" || (t == "integer" && x == float64(int64(x))) {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case []any:
+			if t == "array" {
+				return true
+			}
+		case map[string]any:
+			if t == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// canonicalJSON re-encodes value so that equal values compare equal
+// regardless of spacing, key order or number formatting
+func canonicalJSON(value json.RawMessage) string {
+	var v any
+	if err := json.Unmarshal(value, &v); err != nil {
+		return string(value)
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// voteFields merges the conforming answers field by field: each field takes
+// the value most members gave it, the earliest member's winning a tie. It
+// returns the merged object, the vote on each field in name order and the
+// answers that did not conform.
+func voteFields(responses []copilot.Response, schema outputSchema) (string, []FieldVote, []SchemaViolation) {
+	var answers []map[string]json.RawMessage
+	var violations []SchemaViolation
+	for _, resp := range responses {
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		fields, err := parseSchemaAnswer(resp.Content, schema)
+		if err != nil {
+			violations = append(violations, SchemaViolation{Member: resp.Label(), Reason: err.Error()})
+			continue
+		}
+		answers = append(answers, fields)
+	}
+	if len(answers) == 0 {
+		return "", nil, violations
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	merged := make(map[string]json.RawMessage)
+	var votes []FieldVote
+	for _, name := range names {
+		counts := make(map[string]int)
+		var order []string // Values in the order members first gave them
+		for _, fields := range answers {
+			value, ok := fields[name]
+			if !ok {
+				continue
+			}
+			key := canonicalJSON(value)
+			if counts[key] == 0 {
+				order = append(order, key)
+			}
+			counts[key]++
+		}
+		if len(order) == 0 {
+			continue
+		}
+
+		vote := FieldVote{Field: name}
+		best := ""
+		for _, key := range order {
+			vote.Voters += counts[key]
+			switch {
+			case counts[key] > vote.Votes:
+				best, vote.Votes, vote.Tied = key, counts[key], false
+			case counts[key] == vote.Votes:
+				vote.Tied = true
+			}
+		}
+		vote.Value = json.RawMessage(best)
+		merged[name] = vote.Value
+		votes = append(votes, vote)
+	}
+
+	data, _ := json.MarshalIndent(merged, "", "  ")
+	return string(data), votes, violations
+}
+
+// voteOnFields makes the field-by-field vote over the members' answers the
+// final answer, in place of peer review and the chairman
+func (c *Council) voteOnFields(result *Result) {
+	schema, err := parseOutputSchema(c.config.OutputSchema)
+	if err != nil {
+		result.Error = err
+		return
+	}
+	result.AggregatedResponse, result.FieldVotes, result.NonConforming = voteFields(result.ModelResponses, schema)
+	if result.AggregatedResponse == "" {
+		result.Error = fmt.Errorf("no member answered with JSON matching the output schema")
+	}
+}
@@ -0,0 +1,49 @@
+package council
+
+import "strings"
+
+// Framings set the wording of the review and synthesis prompts
+const (
+	FramingCouncil = "council" // An AI Council whose Chairman synthesizes its members' answers
+	FramingNeutral = "neutral" // Plain terms: a synthesizer, evaluators and candidate answers
+)
+
+// Framings lists the supported framings, default first
+var Framings = []string{FramingCouncil, FramingNeutral}
+
+// IsFraming reports whether framing names a supported framing
+func IsFraming(framing string) bool {
+	return framing == FramingCouncil || framing == FramingNeutral
+}
+
+// neutralWording rewrites the council terms in the chairman's task
+// instructions and notes for FramingNeutral
+var neutralWording = strings.NewReplacer(
+	"The council expects", "The reader expects",
+	"The council members", "The models",
+	"the council members", "the models",
+	"the council converges on", "the models converge on",
+	"the council's consensus", "the consensus",
+)
+
+// neutral reports whether the prompts use FramingNeutral
+func (c *Council) neutral() bool {
+	return c.config.Framing == FramingNeutral
+}
+
+// framed returns the wording of the prompts' council terms in the
+// configured framing
+func (c *Council) framed(text string) string {
+	if c.neutral() {
+		return neutralWording.Replace(text)
+	}
+	return text
+}
+
+// aggregatorRole is who the synthesis prompts tell the aggregator it is
+func (c *Council) aggregatorRole() string {
+	if c.neutral() {
+		return "a synthesizer"
+	}
+	return "the Chairman of an AI Council"
+}
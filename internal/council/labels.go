@@ -0,0 +1,92 @@
+package council
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// Label schemes for anonymized responses in peer review
+const (
+	LabelsAlpha   = "alpha"   // Response A, Response B, ...
+	LabelsNumeric = "numeric" // Response 1, Response 2, ...
+	LabelsGreek   = "greek"   // Response Alpha, Response Beta, ...
+)
+
+// LabelSchemes lists the supported review label schemes, default first
+var LabelSchemes = []string{LabelsAlpha, LabelsNumeric, LabelsGreek}
+
+var greekLetters = []string{
+	"Alpha", "Beta", "Gamma", "Delta", "Epsilon", "Zeta", "Eta", "Theta",
+	"Iota", "Kappa", "Lambda", "Mu", "Nu", "Xi", "Omicron", "Pi",
+	"Rho", "Sigma", "Tau", "Upsilon", "Phi", "Chi", "Psi", "Omega",
+}
+
+// IsLabelScheme reports whether scheme names a supported label scheme
+func IsLabelScheme(scheme string) bool {
+	for _, s := range LabelSchemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// reviewLabels returns n distinct labels in the given scheme. Alphabetic labels
+// continue A..Z, AA, AB, ... and Greek ones repeat with a number, so any
+// council size can be labeled. Unknown schemes fall back to alpha.
+func reviewLabels(scheme string, n int) []string {
+	labels := make([]string, n)
+	for i := range labels {
+		switch scheme {
+		case LabelsNumeric:
+			labels[i] = fmt.Sprintf("%d", i+1)
+		case LabelsGreek:
+			labels[i] = greekLetters[i%len(greekLetters)]
+			if round := i / len(greekLetters); round > 0 {
+				labels[i] = fmt.Sprintf("%s%d", labels[i], round+1)
+			}
+		default:
+			labels[i] = alphaLabel(i)
+		}
+	}
+	return labels
+}
+
+// alphaLabel returns the spreadsheet-style label for index i (0 = A, 26 = AA)
+func alphaLabel(i int) string {
+	label := ""
+	for i >= 0 {
+		label = string(rune('A'+i%26)) + label
+		i = i/26 - 1
+	}
+	return label
+}
+
+// describeLabels shows the first labels of a scheme for the review prompt
+func describeLabels(scheme string) string {
+	labels := reviewLabels(scheme, 2)
+	return fmt.Sprintf("Response %s, Response %s, etc.", labels[0], labels[1])
+}
+
+// responseLabels returns the labels of the responses shown to a reviewer:
+// their members' names with RevealAuthors, or else the anonymous scheme
+func (c *Council) responseLabels(responses []copilot.Response) []string {
+	if !c.config.RevealAuthors {
+		return reviewLabels(c.config.ReviewLabels, len(responses))
+	}
+	labels := make([]string, len(responses))
+	for i, resp := range responses {
+		labels[i] = resp.Label()
+	}
+	return labels
+}
+
+// labelPattern matches "Response <label>" as a whole word, so that
+// "Response A" does not match "Response AB" nor "Response 1" match "Response 12".
+// Model names may contain dots, dashes and slashes, so "Response gpt-5" does
+// not match "Response gpt-5.2" either, while "Response gpt-5. Clear" does.
+func labelPattern(label string) *regexp.Regexp {
+	return regexp.MustCompile(`\bResponse\s+` + regexp.QuoteMeta(label) + `(?:$|[^\w.\-/]|\.(?:$|\s))`)
+}
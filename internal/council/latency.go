@@ -0,0 +1,62 @@
+package council
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// latencyOrder returns the order in which to launch requests to members:
+// those whose model has the lowest latency hint first, then the members
+// without a hint in their configured order. Without hints the configured
+// order is kept.
+func latencyOrder(members []copilot.Member, hints map[string]time.Duration) []int {
+	order := make([]int, len(members))
+	for i := range order {
+		order[i] = i
+	}
+	if len(hints) == 0 {
+		return order
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		hintA, okA := hints[members[order[a]].Model]
+		hintB, okB := hints[members[order[b]].Model]
+		if okA != okB {
+			return okA
+		}
+		return okA && hintA < hintB
+	})
+	return order
+}
+
+// OrderByLatency returns models sorted like latencyOrder: those with the
+// lowest hint first, then the models without a hint in their given order
+func OrderByLatency(models []string, hints map[string]time.Duration) []string {
+	ordered := make([]string, len(models))
+	for i, j := range latencyOrder(copilot.ModelMembers(models), hints) {
+		ordered[i] = models[j]
+	}
+	return ordered
+}
+
+// askInLatencyOrder asks every member like askWithResponses, launching the
+// likely-fast models first: AskMultipleModels grants its MaxConcurrency
+// slots in the order of the members it is given. Responses come back in the
+// members' order.
+func (c *Council) askInLatencyOrder(ctx context.Context, members []copilot.Member, prompt string, onResponse copilot.ResponseCallback) []copilot.Response {
+	order := latencyOrder(members, c.config.LatencyHints)
+	scheduled := make([]copilot.Member, len(members))
+	for i, j := range order {
+		scheduled[i] = members[j]
+	}
+
+	answered := askWithResponses(ctx, c.client, scheduled, prompt, c.config.Timeout, onResponse)
+	responses := make([]copilot.Response, len(members))
+	for i, j := range order {
+		responses[j] = answered[i]
+	}
+	return responses
+}
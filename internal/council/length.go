@@ -0,0 +1,125 @@
+package council
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// Answer length targets for the final answer
+const (
+	LengthShort  = "short"
+	LengthMedium = "medium"
+	LengthLong   = "long"
+)
+
+// AnswerLengths lists the supported answer length targets
+var AnswerLengths = []string{LengthShort, LengthMedium, LengthLong}
+
+var lengthInstructions = map[string]string{
+	LengthShort:  "Keep the final answer short: a single paragraph of about 100 words at most.",
+	LengthMedium: "Aim for a medium-length final answer of roughly 200 to 400 words.",
+	LengthLong:   "Give a thorough, detailed final answer; cover the topic fully rather than briefly.",
+}
+
+// IsAnswerLength reports whether length names a supported answer length target
+func IsAnswerLength(length string) bool {
+	_, ok := lengthInstructions[length]
+	return ok
+}
+
+// lengthInstruction is the advisory length constraint added to the aggregation
+// prompt, or "" when no target is set
+func lengthInstruction(length string, maxWords int) string {
+	var parts []string
+	if instruction, ok := lengthInstructions[length]; ok {
+		parts = append(parts, instruction)
+	}
+	if maxWords > 0 {
+		parts = append(parts, fmt.Sprintf("The final answer must not exceed %d words.", maxWords))
+	}
+	return strings.Join(parts, " ")
+}
+
+// describeLength summarizes the requested length, e.g. "short, max 150 words"
+func describeLength(length string, maxWords int) string {
+	var parts []string
+	if IsAnswerLength(length) {
+		parts = append(parts, length)
+	}
+	if maxWords > 0 {
+		parts = append(parts, fmt.Sprintf("max %d words", maxWords))
+	}
+	return strings.Join(parts, ", ")
+}
+
+var (
+	wordPattern        = regexp.MustCompile(`\S+`)
+	sentenceEndPattern = regexp.MustCompile(`[.!?。！？]["')\]]*(\s|$)`)
+)
+
+// trimToWords cuts text to at most maxWords words, at the last sentence
+// boundary when there is one, and reports whether anything was cut
+func trimToWords(text string, maxWords int) (string, bool) {
+	words := wordPattern.FindAllStringIndex(text, maxWords+1)
+	if maxWords <= 0 || len(words) <= maxWords {
+		return text, false
+	}
+
+	kept := text[:words[maxWords-1][1]]
+	if ends := sentenceEndPattern.FindAllStringIndex(kept+" ", -1); len(ends) > 0 {
+		return strings.TrimSpace(kept[:min(ends[len(ends)-1][1], len(kept))]), true
+	}
+	return strings.TrimSpace(kept) + "…", true
+}
+
+// markInsufficient fails the answers shorter than minLength characters, or
+// words when words is set, with ErrInsufficientContent
+func markInsufficient(responses []copilot.Response, minLength int, words bool) {
+	if minLength <= 0 {
+		return
+	}
+	for i := range responses {
+		resp := &responses[i]
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		length, unit := answerLength(resp.Content, words)
+		if length < minLength {
+			resp.Error = fmt.Errorf("%w: %d %s, expected at least %d", ErrInsufficientContent, length, unit, minLength)
+		}
+	}
+}
+
+// answerLength measures an answer in characters, or words when words is set,
+// and names the unit
+func answerLength(content string, words bool) (int, string) {
+	if words {
+		return len(wordPattern.FindAllStringIndex(content, -1)), "words"
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(content)), "characters"
+}
+
+// tooShortToReview says why peer review is pointless when every successful
+// response is shorter than minLength characters (or words), and returns ""
+// when at least one is long enough
+func tooShortToReview(responses []copilot.Response, minLength int, words bool) string {
+	if minLength <= 0 {
+		return ""
+	}
+	unit := "characters"
+	for _, resp := range responses {
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		var length int
+		length, unit = answerLength(resp.Content, words)
+		if length >= minLength {
+			return ""
+		}
+	}
+	return fmt.Sprintf("all answers under %d %s", minLength, unit)
+}
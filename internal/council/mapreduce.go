@@ -0,0 +1,165 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// How the chairman synthesizes the final answer
+const (
+	AggregationSingle    = "single"    // One prompt with every response and review (the default)
+	AggregationMapReduce = "mapreduce" // Responses are summarized in groups first, then the summaries are synthesized
+)
+
+// AggregationStrategies lists the valid aggregation strategies, default first
+var AggregationStrategies = []string{AggregationSingle, AggregationMapReduce}
+
+// IsAggregationStrategy reports whether strategy is a valid aggregation strategy
+func IsAggregationStrategy(strategy string) bool {
+	for _, s := range AggregationStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+// mapReduceGroupSize is how many responses the chairman summarizes at once.
+// Councils with no more answers than this are aggregated in a single prompt.
+const mapReduceGroupSize = 4
+
+// GroupSummary is the chairman's summary of one group of responses, made
+// before the final synthesis with AggregationMapReduce
+type GroupSummary struct {
+	Members  []string // Members whose responses were summarized
+	Summary  string
+	Duration time.Duration
+	Err      error // The group's responses go to the final synthesis verbatim instead
+}
+
+// mapReduces reports whether the synthesis of result goes through group summaries
+func (c *Council) mapReduces(result Result) bool {
+	return c.config.AggregationStrategy == AggregationMapReduce &&
+		c.config.Mode != ModeProposer &&
+		len(answeredResponses(result.ModelResponses)) > mapReduceGroupSize
+}
+
+// summarizeGroups has the aggregator summarize the answered responses in
+// groups of mapReduceGroupSize, in parallel
+func (c *Council) summarizeGroups(ctx context.Context, question string, result Result, emit EventHandler) []GroupSummary {
+	answers := answeredResponses(result.ModelResponses)
+	var groups [][]copilot.Response
+	for start := 0; start < len(answers); start += mapReduceGroupSize {
+		groups = append(groups, answers[start:min(start+mapReduceGroupSize, len(answers))])
+	}
+
+	scores := make(map[string]float64, len(result.Consensus))
+	for _, score := range result.Consensus {
+		scores[score.Model] = score.Score
+	}
+
+	emit(Event{Type: EventPhaseStart, Phase: PhaseMap, Count: len(groups)})
+	summaries := make([]GroupSummary, len(groups))
+	aggregator := c.modelMember(result.AggregatorModel)
+	var wg sync.WaitGroup
+	for i, group := range groups {
+		wg.Add(1)
+		go func(idx int, group []copilot.Response) {
+			defer wg.Done()
+
+			summary := GroupSummary{}
+			for _, resp := range group {
+				summary.Members = append(summary.Members, resp.Label())
+			}
+			// A panic fails this group only; its members are passed on verbatim
+			defer recoverPanic(func(err error) {
+				summary.Err = err
+				emit(Event{Type: EventFinished, Phase: PhaseMap, Member: aggregator.Name, Model: aggregator.Model, Count: idx + 1, Err: err})
+				summaries[idx] = summary
+			})
+			prompt := buildGroupPrompt(question, group, scores)
+			emit(Event{Type: EventStarted, Phase: PhaseMap, Member: aggregator.Name, Model: aggregator.Model, Count: idx + 1})
+			c.tracePrompt(PhaseMap, aggregator.Name, prompt)
+			summary.Summary, summary.Duration, summary.Err = c.client.AskSingleModel(ctx, aggregator, prompt, c.config.Timeout)
+			if summary.Err == nil && strings.TrimSpace(summary.Summary) == "" {
+				summary.Err = copilot.ErrEmptyResponse
+			}
+			emit(Event{Type: EventFinished, Phase: PhaseMap, Member: aggregator.Name, Model: aggregator.Model, Count: idx + 1, Duration: summary.Duration, Err: summary.Err})
+			summaries[idx] = summary
+		}(i, group)
+	}
+	wg.Wait()
+	return summaries
+}
+
+// buildGroupPrompt asks for a summary of one group of responses that keeps
+// what a final synthesis needs: the points made, where they disagree, and
+// how peer review rated them
+func buildGroupPrompt(question string, group []copilot.Response, scores map[string]float64) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`You are helping the Chairman of an AI Council. Several AI models answered the following question; summarize the group of their responses below.
+
+Original Question: "%s"
+
+`, question))
+	for _, resp := range group {
+		sb.WriteString(fmt.Sprintf("### Response from %s", resp.Label()))
+		if score, ok := scores[resp.Label()]; ok {
+			sb.WriteString(fmt.Sprintf(" (peer review score %.2f)", score))
+		}
+		sb.WriteString(":\n")
+		if resp.Partial {
+			sb.WriteString(partialNote)
+		}
+		if resp.Refused {
+			sb.WriteString(refusalNote)
+		}
+		sb.WriteString(resp.Content)
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString(`Write a compact summary for the Chairman, who will combine it with the summaries of other groups:
+1. The key points and recommendations, noting which responses make each
+2. Where the responses disagree, and which side is better supported
+3. Anything a response gets wrong
+Keep concrete details (names, numbers, code) that the final answer may need.
+
+Your summary:`)
+	return sb.String()
+}
+
+// buildReducePrompt asks the chairman for the final answer from the group
+// summaries. Groups that could not be summarized are shown verbatim.
+func (c *Council) buildReducePrompt(question string, responses []copilot.Response, summaries []GroupSummary) string {
+	byLabel := make(map[string]copilot.Response, len(responses))
+	for _, resp := range responses {
+		byLabel[resp.Label()] = resp
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`You are %s. %d AI models answered the following question, and their responses were summarized in %d groups.
+
+Original Question: "%s"
+
+`, c.aggregatorRole(), len(responses), len(summaries), question))
+
+	sb.WriteString("## Group Summaries:\n\n")
+	for i, summary := range summaries {
+		sb.WriteString(fmt.Sprintf("### Group %d - %s:\n", i+1, strings.Join(summary.Members, ", ")))
+		if summary.Err == nil {
+			sb.WriteString(strings.TrimSpace(summary.Summary))
+			sb.WriteString("\n\n")
+			continue
+		}
+		for _, member := range summary.Members {
+			sb.WriteString(fmt.Sprintf("#### Response from %s:\n%s\n\n", member, byLabel[member].Content))
+		}
+	}
+
+	c.writeChairmanTask(&sb, "group summaries")
+	return sb.String()
+}
@@ -0,0 +1,118 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoSubAnswers reports that the council could not answer any question of ExecuteMulti
+var ErrNoSubAnswers = errors.New("no question was answered")
+
+// ExecuteMulti runs the council on each question in turn, reusing the same
+// client, and keeps each run in SubResults. With meta, one more aggregation
+// call merges the answers into a single document; otherwise the answers are
+// joined under their questions. The combined answer is AggregatedResponse.
+func (c *Council) ExecuteMulti(ctx context.Context, questions []string, meta bool, handler EventHandler) Result {
+	emit := timestamped(handler)
+
+	var result Result
+	for i, question := range questions {
+		if ctx.Err() != nil {
+			break
+		}
+		emit(Event{Type: EventPhaseStart, Phase: PhaseQuestion, Count: i + 1, Prompt: question})
+		result.SubResults = append(result.SubResults, c.ExecuteWithEvents(ctx, question, handler))
+	}
+
+	answered := 0
+	for _, sub := range result.SubResults {
+		if sub.Error == nil {
+			answered++
+		}
+	}
+	if answered == 0 {
+		result.Error = ErrNoSubAnswers
+		if ctx.Err() != nil {
+			result.Error = ctx.Err()
+		}
+		return result
+	}
+
+	// Without syntheses there is nothing to combine
+	if c.gathers() {
+		return result
+	}
+	if !meta {
+		result.AggregatedResponse = joinSubAnswers(questions, result.SubResults)
+		return result
+	}
+
+	result.AggregatorModel = c.metaAggregator(result.SubResults)
+	result.AggregationPrompt = buildMetaPrompt(questions, result.SubResults)
+	emit(Event{Type: EventPhaseStart, Phase: PhaseMeta, Count: answered})
+	emit(Event{Type: EventStarted, Phase: PhaseMeta, Member: result.AggregatorModel, Model: result.AggregatorModel})
+	c.tracePrompt(PhaseMeta, result.AggregatorModel, result.AggregationPrompt)
+	answer, duration, err := c.client.AskSingleModel(ctx, c.modelMember(result.AggregatorModel), result.AggregationPrompt, c.config.Timeout)
+	emit(Event{Type: EventFinished, Phase: PhaseMeta, Member: result.AggregatorModel, Model: result.AggregatorModel, Duration: duration, Err: err})
+	result.AggregationDuration = duration
+	if err != nil {
+		result.Error = fmt.Errorf("meta-aggregation failed: %w", err)
+		return result
+	}
+	result.AggregatedResponse = answer
+	return result
+}
+
+// metaAggregator picks the model that merges the answers: the configured
+// aggregator, or with AutoAggregator the one that synthesized the first answer
+func (c *Council) metaAggregator(subResults []Result) string {
+	if c.config.Aggregator != AutoAggregator {
+		return c.config.Aggregator
+	}
+	for _, sub := range subResults {
+		if sub.Error == nil && sub.AggregatorModel != "" {
+			return sub.AggregatorModel
+		}
+	}
+	return DefaultAggregator()
+}
+
+// joinSubAnswers lists each answered question with its answer
+func joinSubAnswers(questions []string, subResults []Result) string {
+	var sb strings.Builder
+	for i, sub := range subResults {
+		if sub.Error != nil {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(fmt.Sprintf("## %s\n\n%s", questions[i], strings.TrimSpace(sub.AggregatedResponse)))
+	}
+	return sb.String()
+}
+
+// buildMetaPrompt asks the chairman to merge the council's answers to the parts
+// of a larger question. Parts the council failed to answer are left out.
+func buildMetaPrompt(questions []string, subResults []Result) string {
+	var sb strings.Builder
+	sb.WriteString("You are the Chairman of an AI Council. The council answered a larger question in several parts, each through its own discussion and peer review.\n\n")
+
+	part := 0
+	for i, sub := range subResults {
+		if sub.Error != nil {
+			continue
+		}
+		part++
+		sb.WriteString(fmt.Sprintf("## Part %d: %s\n\n%s\n\n", part, questions[i], strings.TrimSpace(sub.AggregatedResponse)))
+	}
+
+	sb.WriteString("Your task: combine these answers into one coherent document that covers every part.\n")
+	sb.WriteString("- Keep the substance of each answer; do not add claims none of them make\n")
+	sb.WriteString("- Remove repetition between parts and order the material logically\n")
+	sb.WriteString("- Where parts contradict each other, say so explicitly\n\n")
+	sb.WriteString("Provide the combined document:")
+	return sb.String()
+}
@@ -0,0 +1,97 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// critique shows the proposal to every critic in parallel and collects their
+// critiques, in the critics' order. Failed critiques keep their error.
+func (c *Council) critique(ctx context.Context, question string, proposal copilot.Response, critics []copilot.Member, emit EventHandler) []copilot.Response {
+	emit(Event{Type: EventPhaseStart, Phase: PhaseCritique, Count: len(critics)})
+	prompt := buildCritiquePrompt(question, proposal.Content)
+
+	critiques := make([]copilot.Response, len(critics))
+	var wg sync.WaitGroup
+	for i, critic := range critics {
+		wg.Add(1)
+		go func(idx int, critic copilot.Member) {
+			defer wg.Done()
+			defer recoverPanic(func(err error) {
+				emit(Event{Type: EventFinished, Phase: PhaseCritique, Member: critic.Name, Model: critic.Model, Err: err})
+				critiques[idx] = copilot.Response{Member: critic.Name, Model: critic.Model, Error: err}
+			})
+
+			emit(Event{Type: EventStarted, Phase: PhaseCritique, Member: critic.Name, Model: critic.Model})
+			c.tracePrompt(PhaseCritique, critic.Name, prompt)
+			content, duration, err := c.client.AskSingleModel(ctx, critic, prompt, c.config.Timeout)
+			if err == nil && strings.TrimSpace(content) == "" {
+				err = copilot.ErrEmptyResponse
+			}
+			emit(Event{Type: EventFinished, Phase: PhaseCritique, Member: critic.Name, Model: critic.Model, Content: content, Duration: duration, Err: err})
+			critiques[idx] = copilot.Response{Member: critic.Name, Model: critic.Model, Content: content, Duration: duration, Error: err}
+		}(i, critic)
+	}
+	wg.Wait()
+	return critiques
+}
+
+// executeProposal runs stage 2 of ModeProposer: the first member's answer in
+// result.ModelResponses is critiqued by the remaining members
+func (c *Council) executeProposal(ctx context.Context, question string, critics []copilot.Member, result *Result, emit EventHandler) {
+	if len(critics) == 0 {
+		return
+	}
+	start := time.Now()
+	result.Critiques = c.critique(ctx, question, result.ModelResponses[0], critics, emit)
+	result.CritiqueDuration = time.Since(start)
+}
+
+// buildCritiquePrompt asks a critic to find what the proposal gets wrong or
+// leaves out. Unlike peer review, there is nothing to rank: the critic sees a
+// single named proposal and answers with improvements, not a score.
+func buildCritiquePrompt(question, proposal string) string {
+	return fmt.Sprintf(`You are a critic on an AI Council. Another member has proposed an answer to this question: "%s"
+
+## Proposed Answer:
+%s
+
+Critique the proposal: point out anything that is wrong, missing, unclear or poorly argued, and say how to fix it. There is no need to repeat what is already right, and do not rewrite the whole answer.`, question, proposal)
+}
+
+// buildProposalAggregationPrompt asks the chairman to improve the proposal
+// using the critiques that hold up
+func (c *Council) buildProposalAggregationPrompt(question string, proposal copilot.Response, critiques []copilot.Response) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`You are %s. One member proposed an answer to the following question, and the other members critiqued it.
+
+Original Question: "%s"
+
+`, c.aggregatorRole(), question))
+
+	sb.WriteString(fmt.Sprintf("## Proposal from %s:\n\n", proposal.Label()))
+	if proposal.Partial {
+		sb.WriteString(partialNote)
+	}
+	sb.WriteString(proposal.Content)
+	sb.WriteString("\n\n")
+
+	sb.WriteString("## Critiques:\n\n")
+	for _, critique := range critiques {
+		sb.WriteString(fmt.Sprintf("### %s:\n", critique.Label()))
+		if critique.Error != nil {
+			sb.WriteString(fmt.Sprintf("(Error: %v)\n\n", critique.Error))
+		} else {
+			sb.WriteString(critique.Content)
+			sb.WriteString("\n\n")
+		}
+	}
+
+	c.writeChairmanTask(&sb, "proposal and the critiques that hold up")
+	return sb.String()
+}
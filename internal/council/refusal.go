@@ -0,0 +1,87 @@
+package council
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// refusalOpenings are the ways a refusal typically begins. Detection only
+// looks at the opening of short answers, so an answer that merely mentions
+// one of these phrases is not flagged.
+var refusalOpenings = []string{
+	"i can't help with",
+	"i cannot help with",
+	"i can't assist with",
+	"i cannot assist with",
+	"i'm not able to help with",
+	"i am not able to help with",
+	"i'm unable to help with",
+	"i am unable to help with",
+	"i won't be able to help with",
+	"i can't provide",
+	"i cannot provide",
+	"i can't comply",
+	"i cannot comply",
+}
+
+// refusalApologies may precede a refusal ("I'm sorry, but I can't help with that")
+var refusalApologies = []string{"i'm sorry, but ", "i'm sorry, ", "sorry, but ", "sorry, ", "i apologize, but "}
+
+// maxRefusalWords caps the length of an answer that can count as a refusal;
+// longer answers carry content even if they open with a caveat
+const maxRefusalWords = 60
+
+// isRefusal reports whether text declines to answer instead of answering.
+// It is deliberately conservative: only short texts that open with a refusal
+// are flagged.
+func isRefusal(text string) bool {
+	text = strings.TrimSpace(text)
+	if text == "" || len(strings.Fields(text)) > maxRefusalWords {
+		return false
+	}
+
+	opening := strings.ToLower(strings.ReplaceAll(text, "’", "'"))
+	for _, apology := range refusalApologies {
+		if strings.HasPrefix(opening, apology) {
+			opening = strings.TrimPrefix(opening, apology)
+			break
+		}
+	}
+	for _, refusal := range refusalOpenings {
+		if strings.HasPrefix(opening, refusal) {
+			return true
+		}
+	}
+	return false
+}
+
+// markRefusals flags the successful answers that are refusals
+func markRefusals(responses []copilot.Response) {
+	for i := range responses {
+		if responses[i].Error == nil && isRefusal(responses[i].Content) {
+			responses[i].Refused = true
+		}
+	}
+}
+
+// demoteRefusals moves members that refused to the end of the consensus, so
+// that a refusal is never the top-ranked answer however reviewers scored it
+func demoteRefusals(scores []ConsensusScore, responses []copilot.Response) {
+	refused := make(map[string]bool)
+	for _, resp := range responses {
+		if resp.Refused {
+			refused[resp.Label()] = true
+		}
+	}
+	if len(refused) == 0 {
+		return
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return !refused[scores[i].Model] && refused[scores[j].Model]
+	})
+}
+
+// refusalNote flags a refusal inside the aggregation prompt
+const refusalNote = "(This member declined to answer; do not treat it as an answer.)\n"
@@ -0,0 +1,78 @@
+package council
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+func TestIsRefusal(t *testing.T) {
+	refusals := []string{
+		"I can't help with that.",
+		"I’m sorry, but I can’t assist with that request.",
+		"Sorry, I cannot provide instructions for this.",
+		"I am unable to help with that request. Let me know if there's anything else.",
+	}
+	for _, text := range refusals {
+		if !isRefusal(text) {
+			t.Errorf("expected %q to be a refusal", text)
+		}
+	}
+
+	answers := []string{
+		"",
+		"Go is a statically typed, compiled language designed at Google.",
+		"You can't help with goroutine leaks by adding more goroutines; use a context instead.",
+		"I'm sorry for the confusion earlier: the answer is 42.",
+		"I can't provide an exact figure, but" + strings.Repeat(" roughly speaking the estimate is", 15) + " about 3 million.",
+	}
+	for _, text := range answers {
+		if isRefusal(text) {
+			t.Errorf("did not expect %q to be a refusal", text)
+		}
+	}
+}
+
+func TestExecuteDetectRefusals(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-b": {{Content: "I'm sorry, but I can't help with that."}},
+		},
+	}, false)
+	c := NewCouncilWithClient(Config{
+		Models:         []string{"model-a", "model-b"},
+		Aggregator:     "chairman",
+		Timeout:        time.Minute,
+		DetectRefusals: true,
+	}, client)
+
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if result.ModelResponses[0].Refused || !result.ModelResponses[1].Refused {
+		t.Errorf("expected only model-b flagged, got %v and %v", result.ModelResponses[0].Refused, result.ModelResponses[1].Refused)
+	}
+	if len(result.Consensus) > 0 && result.Consensus[0].Model == "model-b" {
+		t.Error("a refusal must not top the consensus")
+	}
+	if !strings.Contains(result.AggregationPrompt, refusalNote) {
+		t.Error("expected the chairman to be told about the refusal")
+	}
+}
+
+func TestDemoteRefusals(t *testing.T) {
+	scores := []ConsensusScore{{Model: "refuser", Score: 1}, {Model: "a", Score: 0.5}, {Model: "b", Score: 0}}
+	demoteRefusals(scores, []copilot.Response{{Model: "refuser", Refused: true}, {Model: "a"}, {Model: "b"}})
+
+	var order []string
+	for _, s := range scores {
+		order = append(order, s.Model)
+	}
+	if got := strings.Join(order, ","); got != "a,b,refuser" {
+		t.Errorf("expected the refusal moved last, got %s", got)
+	}
+}
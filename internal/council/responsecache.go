@@ -0,0 +1,158 @@
+package council
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// ErrNotCached marks a model call that Offline mode could not answer from
+// the ResponseCache
+var ErrNotCached = errors.New("no cached response")
+
+// ResponseCache keeps model answers between runs, so that a repeated prompt
+// can be answered without calling the model again
+type ResponseCache interface {
+	// Load returns the answer stored under key, if there is a fresh one
+	Load(key string) (string, bool)
+	// Store saves an answer under key
+	Store(key, answer string) error
+}
+
+// responseKey identifies a model call by the member asked (name, model,
+// persona and message options) and the full prompt
+func responseKey(member copilot.Member, prompt string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%+v\x00%s", member.Name, member.Model, member.SystemPrompt, member.Options, prompt)))
+	return hex.EncodeToString(sum[:])
+}
+
+// cachingClient answers from a ResponseCache where it can and stores every
+// complete answer of the client it wraps. Storing is best effort: an answer
+// that cannot be cached is still returned.
+type cachingClient struct {
+	ModelClient
+	cache ResponseCache
+}
+
+// AskMultipleModels answers the members found in the cache right away and
+// asks the wrapped client for the rest
+func (c *cachingClient) AskMultipleModels(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, progress copilot.ProgressCallback) []copilot.Response {
+	return c.AskMultipleModelsWithResponses(ctx, members, question, timeout, copilot.ProgressResponses(progress))
+}
+
+// AskMultipleModelsWithResponses is AskMultipleModels, handing each response
+// to onResponse as soon as it is known
+func (c *cachingClient) AskMultipleModelsWithResponses(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response {
+	responses := make([]copilot.Response, len(members))
+	var missing []copilot.Member
+	var missingIndexes []int
+	for i, member := range members {
+		answer, ok := c.cache.Load(responseKey(member, question))
+		if !ok {
+			missing = append(missing, member)
+			missingIndexes = append(missingIndexes, i)
+			continue
+		}
+		responses[i] = copilot.Response{Member: member.Name, Model: member.Model, Content: answer}
+		if onResponse != nil {
+			onResponse(responses[i])
+		}
+	}
+
+	if len(missing) > 0 {
+		for j, resp := range askWithResponses(ctx, c.ModelClient, missing, question, timeout, onResponse) {
+			responses[missingIndexes[j]] = resp
+			if resp.Error == nil && !resp.Partial {
+				c.store(missing[j], question, resp.Content)
+			}
+		}
+	}
+	return responses
+}
+
+// AskSingleModel returns the cached answer, or asks the wrapped client
+func (c *cachingClient) AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	if answer, ok := c.cache.Load(responseKey(member, question)); ok {
+		return answer, 0, nil
+	}
+	answer, duration, err := c.ModelClient.AskSingleModel(ctx, member, question, timeout)
+	if err == nil {
+		c.store(member, question, answer)
+	}
+	return answer, duration, err
+}
+
+// store caches a non-empty answer
+func (c *cachingClient) store(member copilot.Member, question, answer string) {
+	if strings.TrimSpace(answer) != "" {
+		_ = c.cache.Store(responseKey(member, question), answer)
+	}
+}
+
+// offlineClient stands in for the model backend with Config.Offline: every
+// call it gets is one the ResponseCache could not answer, so it fails at
+// once with ErrNotCached and remembers who was asked
+type offlineClient struct {
+	mu      sync.Mutex
+	missing []string
+}
+
+// AskMultipleModels fails every member with ErrNotCached
+func (o *offlineClient) AskMultipleModels(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, progress copilot.ProgressCallback) []copilot.Response {
+	return o.AskMultipleModelsWithResponses(ctx, members, question, timeout, copilot.ProgressResponses(progress))
+}
+
+// AskMultipleModelsWithResponses is AskMultipleModels, handing over each failure
+func (o *offlineClient) AskMultipleModelsWithResponses(ctx context.Context, members []copilot.Member, question string, timeout time.Duration, onResponse copilot.ResponseCallback) []copilot.Response {
+	responses := make([]copilot.Response, len(members))
+	for i, member := range members {
+		responses[i] = copilot.Response{Member: member.Name, Model: member.Model, Error: o.miss(member)}
+		if onResponse != nil {
+			onResponse(responses[i])
+		}
+	}
+	return responses
+}
+
+// AskSingleModel fails with ErrNotCached
+func (o *offlineClient) AskSingleModel(ctx context.Context, member copilot.Member, question string, timeout time.Duration) (string, time.Duration, error) {
+	return "", 0, o.miss(member)
+}
+
+// Close implements ModelClient; there is nothing to release
+func (o *offlineClient) Close() error {
+	return nil
+}
+
+// miss records that member had no cached answer
+func (o *offlineClient) miss(member copilot.Member) error {
+	label := member.Name
+	if label == "" {
+		label = member.Model
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, seen := range o.missing {
+		if seen == label {
+			return ErrNotCached
+		}
+	}
+	o.missing = append(o.missing, label)
+	return ErrNotCached
+}
+
+// takeMissing returns the members that missed the cache since the last call
+func (o *offlineClient) takeMissing() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	missing := o.missing
+	o.missing = nil
+	return missing
+}
@@ -0,0 +1,108 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// Council modes
+const (
+	ModeCouncil    = "council"    // Members answer independently, then rank each other
+	ModeRoundtable = "roundtable" // Members revise their answers after reading each other's
+	ModeProposer   = "proposer"   // The first member proposes an answer, the others critique it
+	ModeGather     = "gather"     // Members answer and rank each other; the synthesis is left to the reader
+)
+
+// Modes lists the supported modes, default first
+var Modes = []string{ModeCouncil, ModeRoundtable, ModeProposer, ModeGather}
+
+// IsMode reports whether mode names a supported mode
+func IsMode(mode string) bool {
+	for _, m := range Modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// roundtable shows every member that answered the others' answers and asks
+// for a revised answer, in parallel. Members whose revision fails keep their
+// first answer. It returns the revised responses and how many were revised.
+func (c *Council) roundtable(ctx context.Context, question string, responses []copilot.Response, emit EventHandler) ([]copilot.Response, int) {
+	revised := make([]copilot.Response, len(responses))
+	copy(revised, responses)
+
+	var answered []int
+	for i, resp := range responses {
+		if resp.Error == nil && resp.Content != "" {
+			answered = append(answered, i)
+		}
+	}
+	emit(Event{Type: EventPhaseStart, Phase: PhaseRoundtable, Count: len(answered)})
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		count int
+	)
+	for _, idx := range answered {
+		resp := responses[idx]
+		wg.Add(1)
+		go func(idx int, resp copilot.Response) {
+			defer wg.Done()
+			// A panic keeps this member's original answer
+			defer recoverPanic(func(err error) {
+				emit(Event{Type: EventFinished, Phase: PhaseRoundtable, Member: resp.Label(), Model: resp.Model, Err: err})
+			})
+
+			var others []string
+			for _, other := range answered {
+				if other != idx {
+					others = append(others, responses[other].Content)
+				}
+			}
+			prompt := buildRoundtablePrompt(question, resp.Content, others)
+
+			emit(Event{Type: EventStarted, Phase: PhaseRoundtable, Member: resp.Label(), Model: resp.Model})
+			c.tracePrompt(PhaseRoundtable, resp.Label(), prompt)
+			content, duration, err := c.client.AskSingleModel(ctx, c.member(resp.Label()), prompt, c.config.Timeout)
+			emit(Event{Type: EventFinished, Phase: PhaseRoundtable, Member: resp.Label(), Model: resp.Model, Content: content, Duration: duration, Err: err})
+			if err != nil || strings.TrimSpace(content) == "" {
+				return
+			}
+
+			revised[idx].Content = content
+			revised[idx].Duration = duration
+			mu.Lock()
+			count++
+			mu.Unlock()
+		}(idx, resp)
+	}
+	wg.Wait()
+	return revised, count
+}
+
+// buildRoundtablePrompt asks a member to rewrite its answer using the best
+// points of its colleagues' answers
+func buildRoundtablePrompt(question, own string, others []string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`You are a member of an AI Council working together on this question: "%s"
+
+## Your Answer:
+%s
+
+`, question, own))
+
+	sb.WriteString("## Your Colleagues' Answers:\n\n")
+	for i, other := range others {
+		sb.WriteString(fmt.Sprintf("### Colleague %d:\n%s\n\n", i+1, other))
+	}
+
+	sb.WriteString(`Revise your answer. Keep what you got right, adopt the best points from your colleagues, and correct anything they showed to be wrong. Reply with the complete revised answer only.`)
+	return sb.String()
+}
@@ -0,0 +1,115 @@
+package council
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// echoThreshold is the similarity above which the final answer is considered
+// a near-verbatim copy of a single member's response
+const echoThreshold = 0.8
+
+// shingleSize is the number of consecutive words compared by textSimilarity
+const shingleSize = 3
+
+// textSimilarity returns the Jaccard similarity (0..1) of the word shingles of a and b.
+// It is insensitive to case, punctuation, and whitespace differences.
+func textSimilarity(a, b string) float64 {
+	setA := shingles(a)
+	setB := shingles(b)
+	if len(setA) == 0 || len(setB) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for s := range setA {
+		if _, ok := setB[s]; ok {
+			intersection++
+		}
+	}
+	union := len(setA) + len(setB) - intersection
+	return float64(intersection) / float64(union)
+}
+
+// shingles splits text into lowercase words and returns the set of word n-grams
+func shingles(text string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	size := shingleSize
+	if len(words) < size {
+		size = len(words)
+	}
+
+	set := make(map[string]struct{})
+	for i := 0; i+size <= len(words) && size > 0; i++ {
+		set[strings.Join(words[i:i+size], " ")] = struct{}{}
+	}
+	return set
+}
+
+// detectEcho finds the member response most similar to the final answer.
+// It returns the model name and similarity when it exceeds echoThreshold.
+func detectEcho(final string, responses []copilot.Response) (string, float64) {
+	bestModel, bestScore := closestResponse(final, responses)
+	if bestScore < echoThreshold {
+		return "", bestScore
+	}
+	return bestModel, bestScore
+}
+
+// closestResponse returns the member whose answer is most similar to the
+// final answer, and that similarity
+func closestResponse(final string, responses []copilot.Response) (string, float64) {
+	bestModel := ""
+	bestScore := 0.0
+	for _, resp := range responses {
+		if resp.Error != nil || resp.Content == "" {
+			continue
+		}
+		if score := textSimilarity(final, resp.Content); score > bestScore {
+			bestModel = resp.Label()
+			bestScore = score
+		}
+	}
+	return bestModel, bestScore
+}
+
+// unanimityThreshold is the pairwise similarity every answer must reach for
+// the council to count as unanimous under SmartAggregate
+const unanimityThreshold = 0.8
+
+// unanimousAnswer reports whether at least two members answered and every pair
+// of answers is a near-duplicate. It returns the answer to stand in for the
+// synthesis: the consensus leader's, or the first one when there is no ranking.
+func unanimousAnswer(responses []copilot.Response, consensus []ConsensusScore) (copilot.Response, bool) {
+	var answers []copilot.Response
+	for _, resp := range responses {
+		if resp.Error == nil && resp.Content != "" {
+			answers = append(answers, resp)
+		}
+	}
+	if len(answers) < 2 {
+		return copilot.Response{}, false
+	}
+
+	for i := range answers {
+		for j := i + 1; j < len(answers); j++ {
+			if textSimilarity(answers[i].Content, answers[j].Content) < unanimityThreshold {
+				return copilot.Response{}, false
+			}
+		}
+	}
+
+	if len(consensus) > 0 {
+		for _, resp := range answers {
+			if resp.Label() == consensus[0].Model {
+				return resp, true
+			}
+		}
+	}
+	return answers[0], true
+}
@@ -0,0 +1,60 @@
+package council
+
+import (
+	"testing"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+func TestTextSimilarity(t *testing.T) {
+	a := "Paris is the capital of France and its largest city."
+	if got := textSimilarity(a, a); got != 1 {
+		t.Errorf("identical texts: expected 1, got %f", got)
+	}
+	if got := textSimilarity(a, "PARIS is the capital of France, and its largest city!"); got != 1 {
+		t.Errorf("case and punctuation should be ignored, got %f", got)
+	}
+	if got := textSimilarity(a, "Goroutines are lightweight threads managed by the Go runtime."); got != 0 {
+		t.Errorf("unrelated texts: expected 0, got %f", got)
+	}
+	if got := textSimilarity("", a); got != 0 {
+		t.Errorf("empty text: expected 0, got %f", got)
+	}
+}
+
+func TestDetectEcho(t *testing.T) {
+	responses := []copilot.Response{
+		{Model: "model-a", Content: "Use channels to communicate between goroutines and avoid shared memory."},
+		{Model: "model-b", Content: "Prefer a mutex when protecting a small piece of shared state."},
+	}
+
+	model, score := detectEcho("Use channels to communicate between goroutines and avoid shared memory.", responses)
+	if model != "model-a" {
+		t.Errorf("expected echo of model-a, got %q (%.2f)", model, score)
+	}
+
+	model, _ = detectEcho("Both channels and mutexes have their place; pick channels for ownership transfer and mutexes for guarding state.", responses)
+	if model != "" {
+		t.Errorf("expected no echo for a synthesized answer, got %q", model)
+	}
+}
+
+func TestDistinctViewpoints(t *testing.T) {
+	channels := "Use channels to communicate between goroutines and avoid shared memory."
+	responses := []copilot.Response{
+		{Model: "model-a", Content: channels},
+		{Model: "model-b", Content: "Use channels to communicate between goroutines and avoid shared memory where possible."},
+		{Model: "model-c", Content: "Prefer a mutex when protecting a small piece of shared state."},
+		{Model: "model-d", Content: channels, Error: copilot.ErrTimeout},
+		{Model: "model-e"},
+	}
+	if got := distinctViewpoints(responses); got != 2 {
+		t.Errorf("expected 2 distinct viewpoints, got %d", got)
+	}
+	if got := distinctViewpoints(responses[:2]); got != 1 {
+		t.Errorf("expected a rephrased copy to share a viewpoint, got %d", got)
+	}
+	if got := distinctViewpoints(nil); got != 0 {
+		t.Errorf("expected no viewpoints without answers, got %d", got)
+	}
+}
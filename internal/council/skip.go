@@ -0,0 +1,77 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// ErrSkipped reports a member the user stopped waiting for with SkipPending
+var ErrSkipped = errors.New("skipped by user")
+
+// skipSwitch lets the user cut a query phase short: while armed, firing it
+// cancels the requests still in flight
+type skipSwitch struct {
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	fired   bool
+	skipped map[string]bool // Members whose request failed after the switch fired
+}
+
+// arm returns the context for a query phase's requests, which fire cancels
+func (s *skipSwitch) arm(ctx context.Context) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancel, s.fired, s.skipped = cancel, false, make(map[string]bool)
+	return ctx
+}
+
+// disarm ends the query phase
+func (s *skipSwitch) disarm() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+}
+
+// fire cancels the armed query phase, reporting false when none is running
+func (s *skipSwitch) fire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel == nil {
+		return false
+	}
+	s.cancel()
+	s.cancel, s.fired = nil, true
+	return true
+}
+
+// markSkipped reports resp as skipped if it failed once the switch fired.
+// Called again with the same member, it applies the verdict of the first call,
+// so a response is judged by the moment it completed.
+func (s *skipSwitch) markSkipped(resp *copilot.Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	skipped, judged := s.skipped[resp.Label()]
+	if !judged {
+		skipped = s.fired && resp.Error != nil
+		s.skipped[resp.Label()] = skipped
+	}
+	if skipped {
+		resp.Error = ErrSkipped
+		resp.ErrorDetail = nil
+	}
+}
+
+// SkipPending stops waiting for the members still answering the current
+// query phase: their requests are cancelled and they count as failed with
+// ErrSkipped, so the run moves on with the answers already in. It reports
+// false when no query phase is running.
+func (c *Council) SkipPending() bool {
+	return c.skip.fire()
+}
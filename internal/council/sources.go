@@ -0,0 +1,101 @@
+package council
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// citationPattern finds the sources a response cites: web URLs (bare, in
+// Markdown links or in <autolinks>), DOIs and arXiv identifiers
+var citationPattern = regexp.MustCompile("https?://[^\\s<>\"'`\\[\\]]+|\\b(?i:doi:\\s*)?10\\.\\d{4,9}/[^\\s<>\"'`\\[\\]]+|\\b(?i:arxiv):\\s*\\d{4}\\.\\d{4,5}(?:v\\d+)?")
+
+// citationTrailing is punctuation that ends a sentence rather than a source
+const citationTrailing = ".,;:!?*_~"
+
+// extractCitations returns the sources cited in content, in order of first
+// appearance and without repeats
+func extractCitations(content string) []string {
+	var citations []string
+	seen := make(map[string]bool)
+	for _, match := range citationPattern.FindAllString(content, -1) {
+		citation := normalizeCitation(match)
+		if citation == "" || seen[citation] {
+			continue
+		}
+		seen[citation] = true
+		citations = append(citations, citation)
+	}
+	return citations
+}
+
+// normalizeCitation trims what a pattern match picked up from the sentence
+// around it, and writes DOIs and arXiv IDs in one form so repeats are found
+func normalizeCitation(match string) string {
+	// Drop sentence punctuation and the parenthesis closing a Markdown
+	// link, but keep the balanced ones of URLs like .../Go_(language)
+	citation := match
+	for {
+		trimmed := strings.TrimRight(citation, citationTrailing)
+		if strings.HasSuffix(trimmed, ")") && strings.Count(trimmed, "(") < strings.Count(trimmed, ")") {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		if trimmed == citation {
+			break
+		}
+		citation = trimmed
+	}
+	lower := strings.ToLower(citation)
+	switch {
+	case strings.HasPrefix(lower, "http"):
+		return citation
+	case strings.HasPrefix(lower, "arxiv:"):
+		return "arXiv:" + strings.TrimSpace(citation[len("arxiv:"):])
+	case strings.HasPrefix(lower, "doi:"):
+		citation = strings.TrimSpace(citation[len("doi:"):])
+	}
+	if strings.HasPrefix(citation, "10.") && strings.Contains(citation, "/") {
+		return "doi:" + citation
+	}
+	return ""
+}
+
+// collectCitations fills in the Citations of each answered response and
+// returns every source cited across the council, de-duplicated in council order
+func collectCitations(responses []copilot.Response) []string {
+	var sources []string
+	seen := make(map[string]bool)
+	for i := range responses {
+		if responses[i].Error != nil {
+			continue
+		}
+		responses[i].Citations = extractCitations(responses[i].Content)
+		for _, citation := range responses[i].Citations {
+			if !seen[strings.ToLower(citation)] {
+				seen[strings.ToLower(citation)] = true
+				sources = append(sources, citation)
+			}
+		}
+	}
+	return sources
+}
+
+// citeInstruction asks the chairman to keep the members' sources
+const citeInstruction = "Where the council members cite sources (URLs, DOIs, papers), cite the ones that support your answer inline."
+
+// appendSources ends answer with a numbered "Sources" section listing
+// sources; without sources the answer is returned unchanged
+func appendSources(answer string, sources []string) string {
+	if len(sources) == 0 {
+		return answer
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.TrimRight(answer, "\n"))
+	sb.WriteString("\n\n## Sources\n\n")
+	for i, source := range sources {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, source))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
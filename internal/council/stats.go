@@ -0,0 +1,72 @@
+package council
+
+import "time"
+
+// PhaseStats counts the requests of one phase
+type PhaseStats struct {
+	Total     int
+	Succeeded int
+	Failed    int
+}
+
+// MemberStats is how one member fared answering the question
+type MemberStats struct {
+	Member    string
+	Model     string
+	Succeeded bool
+	Duration  time.Duration
+}
+
+// RunStats are the counts and timings the summary and the reports show
+type RunStats struct {
+	Query    PhaseStats
+	Review   PhaseStats // Reviews skipped once the quorum was reached are not counted
+	Critique PhaseStats
+
+	// The final answer came from the chairman, its cache or a member standing in for it
+	AggregationSucceeded bool
+
+	Members         []MemberStats
+	QueryDuration   time.Duration // Members answer in parallel: the slowest one's time
+	Fastest         string        // Member that answered first; empty if none did
+	FastestDuration time.Duration
+}
+
+// Stats computes the counts and timings of a run. An answer succeeds when
+// it arrived without an error and is not empty.
+func Stats(result Result) RunStats {
+	stats := RunStats{
+		Members:              make([]MemberStats, 0, len(result.ModelResponses)),
+		AggregationSucceeded: result.AggregatedResponse != "",
+	}
+	for _, resp := range result.ModelResponses {
+		ok := resp.Error == nil && resp.Content != ""
+		stats.Members = append(stats.Members, MemberStats{Member: resp.Label(), Model: resp.Model, Succeeded: ok, Duration: resp.Duration})
+		stats.Query.count(ok)
+		if resp.Duration > stats.QueryDuration {
+			stats.QueryDuration = resp.Duration
+		}
+		if ok && (stats.Fastest == "" || resp.Duration < stats.FastestDuration) {
+			stats.Fastest, stats.FastestDuration = resp.Label(), resp.Duration
+		}
+	}
+	for _, review := range result.Reviews {
+		if !review.Skipped {
+			stats.Review.count(review.Error == nil)
+		}
+	}
+	for _, critique := range result.Critiques {
+		stats.Critique.count(critique.Error == nil)
+	}
+	return stats
+}
+
+// count adds one request to the phase
+func (s *PhaseStats) count(succeeded bool) {
+	s.Total++
+	if succeeded {
+		s.Succeeded++
+	} else {
+		s.Failed++
+	}
+}
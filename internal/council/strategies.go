@@ -0,0 +1,162 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// Aggregation strategies
+const (
+	StrategyLLM   = "llm"   // The chairman writes a new synthesis (the normal path)
+	StrategyVote  = "vote"  // The consensus leader's answer, verbatim; no extra request
+	StrategyJudge = "judge" // The chairman picks the best answer, verbatim; one short request
+)
+
+// Strategies lists the aggregation strategies in the order they are compared
+var Strategies = []string{StrategyLLM, StrategyVote, StrategyJudge}
+
+// Aggregation is one strategy's final answer
+type Aggregation struct {
+	Strategy string
+	Answer   string
+	Source   string        // Member whose answer was picked verbatim; empty for a synthesis
+	Duration time.Duration // Time spent in the aggregator's request, if one was made
+	Err      error
+}
+
+// Aggregator turns the council's responses and reviews into a final answer
+type Aggregator interface {
+	Aggregate(ctx context.Context, question string, result Result) Aggregation
+}
+
+// errNoAnswers reports a strategy that had no successful response to pick from
+var errNoAnswers = errors.New("no successful responses to choose from")
+
+// voteAggregator picks the answer peer review ranked best
+type voteAggregator struct{}
+
+func (voteAggregator) Aggregate(ctx context.Context, question string, result Result) Aggregation {
+	agg := Aggregation{Strategy: StrategyVote}
+	answers := answeredResponses(result.ModelResponses)
+	if len(answers) == 0 {
+		agg.Err = errNoAnswers
+		return agg
+	}
+
+	// Without usable rankings, the first member to answer wins
+	winner := answers[0]
+	if len(result.Consensus) > 0 {
+		for _, resp := range answers {
+			if resp.Label() == result.Consensus[0].Model {
+				winner = resp
+				break
+			}
+		}
+	}
+	agg.Answer, agg.Source = winner.Content, winner.Label()
+	return agg
+}
+
+// judgeAggregator asks the chairman to pick the best answer rather than write one
+type judgeAggregator struct {
+	c *Council
+}
+
+func (j judgeAggregator) Aggregate(ctx context.Context, question string, result Result) Aggregation {
+	agg := Aggregation{Strategy: StrategyJudge}
+	answers := answeredResponses(result.ModelResponses)
+	if len(answers) == 0 {
+		agg.Err = errNoAnswers
+		return agg
+	}
+
+	judge := result.AggregatorModel
+	if judge == "" || result.AggregationSkipped != "" {
+		judge = j.c.resolveAggregator(result)
+	}
+	prompt := buildJudgePrompt(question, answers)
+	j.c.tracePrompt(PhaseAggregation, judge, prompt)
+	reply, duration, err := j.c.client.AskSingleModel(ctx, j.c.modelMember(judge), prompt, j.c.config.Timeout)
+	agg.Duration = duration
+	if err != nil {
+		agg.Err = err
+		return agg
+	}
+
+	choice, ok := parseJudgeChoice(reply, len(answers))
+	if !ok {
+		agg.Err = fmt.Errorf("judge %s did not name a response on a CHOICE line: %q", judge, strings.TrimSpace(reply))
+		return agg
+	}
+	agg.Answer, agg.Source = answers[choice].Content, answers[choice].Label()
+	return agg
+}
+
+// buildJudgePrompt asks for the number of the best response on a CHOICE line
+func buildJudgePrompt(question string, answers []copilot.Response) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("You are judging answers to this question: \"%s\"\n\n", question))
+	for i, resp := range answers {
+		sb.WriteString(fmt.Sprintf("## Response %d:\n%s\n\n", i+1, resp.Content))
+	}
+	sb.WriteString(fmt.Sprintf("Which response answers the question best? End your reply with exactly one line naming its number (1-%d):\nCHOICE: <number>", len(answers)))
+	return sb.String()
+}
+
+// judgeChoicePattern matches a CHOICE line, allowing Markdown emphasis around it
+var judgeChoicePattern = regexp.MustCompile(`(?im)^[\s*_#]*choice[*_]*\s*:[\s*_]*(?:response\s+)?(\d+)[\s*_.]*$`)
+
+// parseJudgeChoice returns the 0-based index named by the last CHOICE line of reply
+func parseJudgeChoice(reply string, n int) (int, bool) {
+	matches := judgeChoicePattern.FindAllStringSubmatch(reply, -1)
+	if len(matches) == 0 {
+		return 0, false
+	}
+	choice, err := strconv.Atoi(matches[len(matches)-1][1])
+	if err != nil || choice < 1 || choice > n {
+		return 0, false
+	}
+	return choice - 1, true
+}
+
+// answeredResponses returns the responses that have content
+func answeredResponses(responses []copilot.Response) []copilot.Response {
+	var answers []copilot.Response
+	for _, resp := range responses {
+		if resp.Error == nil && resp.Content != "" {
+			answers = append(answers, resp)
+		}
+	}
+	return answers
+}
+
+// compareStrategies puts the run's own synthesis (the llm strategy) next to
+// the vote and judge strategies, which reuse its responses and reviews
+func (c *Council) compareStrategies(ctx context.Context, question string, result Result) []Aggregation {
+	aggregations := []Aggregation{{
+		Strategy: StrategyLLM,
+		Answer:   result.AggregatedResponse,
+		Duration: result.AggregationDuration,
+	}}
+
+	others := []Aggregator{voteAggregator{}, judgeAggregator{c: c}}
+	outcomes := make([]Aggregation, len(others))
+	var wg sync.WaitGroup
+	for i, aggregator := range others {
+		wg.Add(1)
+		go func(idx int, aggregator Aggregator) {
+			defer wg.Done()
+			outcomes[idx] = aggregator.Aggregate(ctx, question, result)
+		}(i, aggregator)
+	}
+	wg.Wait()
+	return append(aggregations, outcomes...)
+}
@@ -0,0 +1,115 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// tieMargin is how close the top two consensus scores must be for
+// Config.Tiebreaker to be asked to decide between them
+const tieMargin = 0.05
+
+// Tiebreak is the Tiebreaker's decision between the two responses tied at
+// the top of the consensus
+type Tiebreak struct {
+	Model    string    // The tiebreaker
+	Between  [2]string // The tied members, in their consensus order
+	Winner   string    // Member the tiebreaker picked; empty when it failed
+	Duration time.Duration
+	Error    error // The request failed or the reply named no winner; the order stands
+}
+
+// tiedAtTop reports whether the two best consensus scores are within tieMargin
+func tiedAtTop(consensus []ConsensusScore) bool {
+	return len(consensus) >= 2 && consensus[0].Score-consensus[1].Score <= tieMargin
+}
+
+// breakTie asks the tiebreaker to compare the two responses tied at the top
+// of the consensus head to head, and moves its pick to the top. It makes no
+// call when there is no tie.
+func (c *Council) breakTie(ctx context.Context, question string, result *Result, emit EventHandler) {
+	if !tiedAtTop(result.Consensus) {
+		return
+	}
+	var tied [2]copilot.Response
+	for i, score := range result.Consensus[:2] {
+		for _, resp := range result.ModelResponses {
+			if resp.Label() == score.Model {
+				tied[i] = resp
+			}
+		}
+		if tied[i].Content == "" {
+			return
+		}
+	}
+
+	tiebreaker := c.modelMember(c.config.Tiebreaker)
+	tiebreak := &Tiebreak{Model: tiebreaker.Name, Between: [2]string{tied[0].Label(), tied[1].Label()}}
+	result.Tiebreak = tiebreak
+	prompt := buildTiebreakPrompt(question, tied[0].Content, tied[1].Content)
+
+	emit(Event{Type: EventPhaseStart, Phase: PhaseTiebreak, Count: 1})
+	emit(Event{Type: EventStarted, Phase: PhaseTiebreak, Member: tiebreaker.Name, Model: tiebreaker.Model})
+	c.tracePrompt(PhaseTiebreak, tiebreaker.Name, prompt)
+	reply, duration, err := c.client.AskSingleModel(ctx, tiebreaker, prompt, c.config.Timeout)
+	emit(Event{Type: EventFinished, Phase: PhaseTiebreak, Member: tiebreaker.Name, Model: tiebreaker.Model, Duration: duration, Err: err})
+	tiebreak.Duration = duration
+	if err != nil {
+		tiebreak.Error = err
+		return
+	}
+
+	pick, ok := parseTiebreak(reply)
+	if !ok {
+		tiebreak.Error = fmt.Errorf("no WINNER line in the tiebreaker's reply")
+		return
+	}
+	tiebreak.Winner = tiebreak.Between[pick]
+	if pick == 1 {
+		result.Consensus[0], result.Consensus[1] = result.Consensus[1], result.Consensus[0]
+	}
+}
+
+// buildTiebreakPrompt asks for a head-to-head comparison of two responses,
+// anonymized as A and B
+func buildTiebreakPrompt(question, a, b string) string {
+	return fmt.Sprintf(`You are breaking a tie in an AI Council's peer review. The council was asked: "%s"
+
+Its reviewers ranked the two responses below about equally. Compare them head to head for accuracy, completeness and clarity, and decide which one is better.
+
+### Response A:
+%s
+
+### Response B:
+%s
+
+Explain your decision briefly, then end your reply with exactly one line:
+WINNER: A
+or
+WINNER: B`, question, a, b)
+}
+
+// parseTiebreak reads the tiebreaker's pick from the last WINNER line of its
+// reply: 0 for Response A, 1 for Response B
+func parseTiebreak(reply string) (int, bool) {
+	lines := strings.Split(reply, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		key, value, ok := strings.Cut(strings.Trim(strings.TrimSpace(lines[i]), "*_#"), ":")
+		if !ok || !strings.EqualFold(strings.Trim(strings.TrimSpace(key), "*_"), "winner") {
+			continue
+		}
+		value = strings.ToUpper(strings.Trim(value, " \t*_."))
+		switch strings.TrimPrefix(value, "RESPONSE ") {
+		case "A":
+			return 0, true
+		case "B":
+			return 1, true
+		}
+		return 0, false
+	}
+	return 0, false
+}
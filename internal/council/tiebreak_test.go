@@ -0,0 +1,126 @@
+package council
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+func TestParseTiebreak(t *testing.T) {
+	tests := []struct {
+		reply string
+		want  int
+		ok    bool
+	}{
+		{"B covers the edge cases.\nWINNER: B", 1, true},
+		{"**WINNER:** A", 0, true},
+		{"Winner: Response B.", 1, true},
+		{"winner: a\n", 0, true},
+		{"WINNER: A\nOn reflection:\nWINNER: B", 1, true},
+		{"Both are equally good.", 0, false},
+		{"WINNER: both", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseTiebreak(tt.reply)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseTiebreak(%q) = %d, %v, want %d, %v", tt.reply, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBreakTie(t *testing.T) {
+	responses := []copilot.Response{
+		{Model: "model-a", Content: "Answer A"},
+		{Model: "model-b", Content: "Answer B"},
+		{Model: "model-c", Content: "Answer C"},
+	}
+	tied := []ConsensusScore{{Model: "model-a", Score: 0.75}, {Model: "model-b", Score: 0.72}, {Model: "model-c", Score: 0.1}}
+	clear := []ConsensusScore{{Model: "model-a", Score: 1}, {Model: "model-b", Score: 0.5}, {Model: "model-c", Score: 0}}
+
+	tests := []struct {
+		name      string
+		consensus []ConsensusScore
+		reply     string
+		asked     bool
+		winner    string
+		top       string
+	}{
+		{"tie, second pick", tied, "B handles the edge cases.\nWINNER: B", true, "model-b", "model-b"},
+		{"tie, first pick", tied, "WINNER: A", true, "model-a", "model-a"},
+		{"tie, no pick", tied, "They are equally good.", true, "", "model-a"},
+		{"clear winner", clear, "WINNER: B", false, "", "model-a"},
+	}
+	for _, tt := range tests {
+		var prompts []string
+		c := NewCouncilWithClient(Config{
+			Models:     []string{"model-a", "model-b", "model-c"},
+			Tiebreaker: "judge",
+			Timeout:    time.Minute,
+			TracePrompt: func(stage, model, prompt string) {
+				prompts = append(prompts, prompt)
+			},
+		}, copilot.NewMockClient(&copilot.MockFixture{
+			Models: map[string][]copilot.MockResponse{"judge": {{Content: tt.reply}}},
+		}, false))
+
+		result := Result{ModelResponses: responses, Consensus: append([]ConsensusScore(nil), tt.consensus...)}
+		c.breakTie(context.Background(), "Which is better?", &result, func(Event) {})
+
+		if !tt.asked {
+			if result.Tiebreak != nil || len(prompts) != 0 {
+				t.Errorf("%s: the tiebreaker should not be asked, got %+v", tt.name, result.Tiebreak)
+			}
+			continue
+		}
+		if result.Tiebreak == nil || len(prompts) != 1 {
+			t.Fatalf("%s: expected one call to the tiebreaker", tt.name)
+		}
+		if !strings.Contains(prompts[0], "### Response A:\nAnswer A") || !strings.Contains(prompts[0], "### Response B:\nAnswer B") || strings.Contains(prompts[0], "Answer C") {
+			t.Errorf("%s: the prompt should compare only the tied answers:\n%s", tt.name, prompts[0])
+		}
+		tb := result.Tiebreak
+		if tb.Model != "judge" || tb.Between != [2]string{"model-a", "model-b"} || tb.Winner != tt.winner || (tt.winner == "") != (tb.Error != nil) {
+			t.Errorf("%s: unexpected tiebreak %+v", tt.name, tb)
+		}
+		if result.Consensus[0].Model != tt.top || result.Consensus[2].Model != "model-c" {
+			t.Errorf("%s: expected %s on top, got %+v", tt.name, tt.top, result.Consensus)
+		}
+	}
+}
+
+func TestExecuteTiebreakerClearWinner(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{
+		Models: map[string][]copilot.MockResponse{
+			"model-a":  {{Content: "Answer A"}, {Content: "Ranking:\n1. Response B: C is thorough\n2. Response A: B is thin"}},
+			"model-b":  {{Content: "Answer B"}, {Content: "Ranking:\n1. Response B: C covers the edge cases\n2. Response A: A is fine"}},
+			"model-c":  {{Content: "Answer C"}, {Content: "Ranking:\n1. Response A: A is solid\n2. Response B: B misses the point"}},
+			"judge":    {{Content: "WINNER: B"}},
+			"chairman": {{Content: "Final"}},
+		},
+	}, false)
+
+	var phases []string
+	c := NewCouncilWithClient(Config{
+		Models:     []string{"model-a", "model-b", "model-c"},
+		Aggregator: "chairman",
+		Tiebreaker: "judge",
+		Timeout:    time.Minute,
+	}, client)
+	result := c.ExecuteWithEvents(context.Background(), "What is Go?", func(event Event) {
+		if event.Type == EventPhaseStart {
+			phases = append(phases, event.Phase)
+		}
+	})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if result.Tiebreak != nil || strings.Contains(strings.Join(phases, " "), PhaseTiebreak) {
+		t.Errorf("a clear winner should not be escalated, got %+v", result.Tiebreak)
+	}
+	if result.Consensus[0].Model != "model-c" {
+		t.Errorf("expected model-c on top, got %+v", result.Consensus)
+	}
+}
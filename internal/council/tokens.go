@@ -0,0 +1,13 @@
+package council
+
+import "unicode/utf8"
+
+// charsPerToken is the rough number of characters per token of English
+// text, the usual rule of thumb across tokenizers
+const charsPerToken = 4
+
+// estimateTokens roughly estimates how many tokens s takes. It is meant to
+// catch prompts that are clearly too long, not to count exactly.
+func estimateTokens(s string) int {
+	return (utf8.RuneCountInString(s) + charsPerToken - 1) / charsPerToken
+}
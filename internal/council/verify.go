@@ -0,0 +1,159 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// unsupportedMarker flags a claim of the final answer that no member made
+const unsupportedMarker = " [unsupported]"
+
+// ClaimCheck is the verdict on one factual claim of the final answer
+type ClaimCheck struct {
+	Claim     string
+	Supported bool
+	Members   []string // Members whose answers back the claim
+	Flagged   bool     // The claim was marked unsupported inline in the answer
+}
+
+// ErrNoChecker reports that the final answer is a member's own and no other
+// member answered, so there is nobody to check its claims but its author
+var ErrNoChecker = errors.New("no other member answered to check the answer's author")
+
+// verifyClaims has the aggregator check each factual claim of the final
+// answer against the members' responses and marks the unsupported ones
+// inline in the answer, which is returned with the verdicts
+func (c *Council) verifyClaims(ctx context.Context, question string, result Result, emit EventHandler) (string, []ClaimCheck, error) {
+	checker, ok := c.claimChecker(result)
+	if !ok {
+		return result.AggregatedResponse, nil, ErrNoChecker
+	}
+	prompt := buildVerifyPrompt(question, result.AggregatedResponse, answeredResponses(result.ModelResponses))
+
+	emit(Event{Type: EventPhaseStart, Phase: PhaseVerify, Count: 1})
+	emit(Event{Type: EventStarted, Phase: PhaseVerify, Member: checker.Name, Model: checker.Model})
+	c.tracePrompt(PhaseVerify, checker.Name, prompt)
+	reply, duration, err := c.client.AskSingleModel(ctx, checker, prompt, c.config.Timeout)
+	emit(Event{Type: EventFinished, Phase: PhaseVerify, Member: checker.Name, Model: checker.Model, Duration: duration, Err: err})
+	if err != nil {
+		return result.AggregatedResponse, nil, err
+	}
+
+	checks := parseClaimChecks(reply)
+	if len(checks) == 0 && strings.TrimSpace(reply) != noClaims {
+		return result.AggregatedResponse, nil, fmt.Errorf("no claim verdicts in the expected format")
+	}
+	answer := flagUnsupported(result.AggregatedResponse, checks)
+	return answer, checks, nil
+}
+
+// claimChecker picks who checks the final answer: the aggregator, or when
+// aggregation was skipped and the answer is a member's own, the best-ranked
+// other member that answered, so that no answer is checked by its author
+func (c *Council) claimChecker(result Result) (copilot.Member, bool) {
+	if result.AggregationSkipped == "" {
+		return c.modelMember(result.AggregatorModel), true
+	}
+	answers := answeredResponses(result.ModelResponses)
+	answered := make(map[string]bool, len(answers))
+	var candidates []string
+	for _, score := range result.Consensus {
+		candidates = append(candidates, score.Model)
+	}
+	for _, resp := range answers {
+		answered[resp.Label()] = true
+		candidates = append(candidates, resp.Label())
+	}
+	for _, name := range candidates {
+		if name != result.AggregatorModel && answered[name] {
+			return c.member(name), true
+		}
+	}
+	return copilot.Member{}, false
+}
+
+// noClaims is the checker's reply for an answer without factual claims
+const noClaims = "NONE"
+
+// buildVerifyPrompt asks for a verdict on every factual claim of the final
+// answer: is it backed by at least one of the responses?
+func buildVerifyPrompt(question, answer string, responses []copilot.Response) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`You are checking the final answer of an AI Council against what its members said. The council was asked: "%s"
+
+`, question))
+	for _, resp := range responses {
+		sb.WriteString(fmt.Sprintf("### Response from %s:\n%s\n\n", resp.Label(), resp.Content))
+	}
+	sb.WriteString(fmt.Sprintf(`## Final Answer:
+%s
+
+List every factual claim the final answer makes and say whether at least one response above supports it. Copy each claim word for word from the final answer. Use exactly this format for each claim, with a blank line between claims:
+
+CLAIM: <the claim, copied from the final answer>
+VERDICT: SUPPORTED or UNSUPPORTED
+BY: <the members whose responses support it, comma-separated, or none>
+
+If the final answer makes no factual claims, reply with exactly: %s`, answer, noClaims))
+	return sb.String()
+}
+
+// parseClaimChecks reads the checker's verdicts. A claim without a verdict
+// counts as unsupported.
+func parseClaimChecks(reply string) []ClaimCheck {
+	var checks []ClaimCheck
+	for _, line := range strings.Split(reply, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(value, "* ") // Markdown emphasis, as in "**CLAIM:** ..."
+		switch strings.ToUpper(strings.Trim(key, "*- ")) {
+		case "CLAIM":
+			if value = strings.Trim(value, `"`); value != "" {
+				checks = append(checks, ClaimCheck{Claim: value})
+			}
+		case "VERDICT":
+			if len(checks) > 0 {
+				checks[len(checks)-1].Supported = strings.EqualFold(strings.Trim(value, "*. "), "SUPPORTED")
+			}
+		case "BY":
+			if len(checks) == 0 {
+				continue
+			}
+			for _, member := range strings.Split(value, ",") {
+				if member = strings.TrimSpace(member); member != "" && !strings.EqualFold(member, "none") {
+					checks[len(checks)-1].Members = append(checks[len(checks)-1].Members, member)
+				}
+			}
+		}
+	}
+	return checks
+}
+
+// flagUnsupported marks each unsupported claim found verbatim in answer
+// with unsupportedMarker, and records which claims were marked
+func flagUnsupported(answer string, checks []ClaimCheck) string {
+	for i := range checks {
+		if checks[i].Supported {
+			continue
+		}
+		claim := strings.TrimRight(checks[i].Claim, ".!?")
+		at := strings.Index(answer, claim)
+		if claim == "" || at < 0 {
+			continue
+		}
+		end := at + len(claim)
+		// The marker follows the sentence's closing punctuation
+		for end < len(answer) && strings.ContainsRune(".!?", rune(answer[end])) {
+			end++
+		}
+		answer = answer[:end] + unsupportedMarker + answer[end:]
+		checks[i].Flagged = true
+	}
+	return answer
+}
@@ -0,0 +1,127 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// CI log formats of --ci-format
+const (
+	CIFormatNone   = "none"   // Plain progress output
+	CIFormatGitHub = "github" // GitHub Actions workflow commands: one log group per phase, annotations per model
+)
+
+// CIFormats lists the valid CI log formats
+var CIFormats = []string{CIFormatNone, CIFormatGitHub}
+
+// ResolveCIFormat returns the CI log format to use for the --ci-format
+// value format. An empty value picks CIFormatGitHub inside GitHub Actions
+// (GITHUB_ACTIONS=true) and CIFormatNone anywhere else.
+func ResolveCIFormat(format string) (string, error) {
+	switch format {
+	case "":
+		if os.Getenv("GITHUB_ACTIONS") == "true" {
+			return CIFormatGitHub, nil
+		}
+		return CIFormatNone, nil
+	case CIFormatNone, CIFormatGitHub:
+		return format, nil
+	}
+	return "", fmt.Errorf("invalid --ci-format %q (valid: %s)", format, strings.Join(CIFormats, ", "))
+}
+
+// ciGroupTitle names the log group of a phase
+func ciGroupTitle(event council.Event) string {
+	switch event.Phase {
+	case council.PhaseWarmup:
+		return "Warming up sessions"
+	case council.PhaseQuery:
+		return "Querying models"
+	case council.PhaseReview:
+		return "Peer review"
+	case council.PhaseRoundtable:
+		return "Revising answers"
+	case council.PhaseCritique:
+		return "Critiquing the proposal"
+	case council.PhaseMap:
+		return "Summarizing in groups"
+	case council.PhaseAggregation:
+		return "Aggregation"
+	case council.PhaseRefine:
+		return fmt.Sprintf("Refinement round %d", event.Count)
+	case council.PhaseDissent:
+		return "Collecting dissents"
+	case council.PhaseVerify:
+		return "Verifying claims"
+	case council.PhaseTiebreak:
+		return "Breaking the tie"
+	case council.PhaseQuestion:
+		return fmt.Sprintf("Question %d", event.Count)
+	case council.PhaseMeta:
+		return "Merging the answers"
+	}
+	return event.Phase
+}
+
+// ciAnnotates reports whether a finished request of this phase gets an
+// annotation. GitHub shows only a few annotations per step, so they are kept
+// for the members' answers and the synthesis.
+func ciAnnotates(phase string) bool {
+	switch phase {
+	case council.PhaseQuery, council.PhaseRoundtable, council.PhaseCritique, council.PhaseAggregation, council.PhaseMeta:
+		return true
+	}
+	return false
+}
+
+// handleCIEvent writes the workflow commands for event: a new log group
+// for each phase and a notice or error for each finished request
+func (p *Printer) handleCIEvent(event council.Event) {
+	if p.ci != CIFormatGitHub {
+		return
+	}
+	switch event.Type {
+	case council.EventPhaseStart:
+		p.ciMu.Lock()
+		defer p.ciMu.Unlock()
+		// Groups cannot nest, so a phase ends the one before it
+		if p.ciGroup {
+			fmt.Fprintln(p.out, "::endgroup::")
+		}
+		fmt.Fprintf(p.out, "::group::%s\n", escapeWorkflowData(ciGroupTitle(event)))
+		p.ciGroup = true
+	case council.EventFinished:
+		if !ciAnnotates(event.Phase) {
+			return
+		}
+		title := escapeWorkflowProperty(event.Member)
+		if event.Err != nil {
+			fmt.Fprintf(p.out, "::error title=%s::%s\n", title, escapeWorkflowData(fmt.Sprintf("%s failed after %.2fs: %v", event.Member, event.Duration.Seconds(), event.Err)))
+			return
+		}
+		fmt.Fprintf(p.out, "::notice title=%s::%s\n", title, escapeWorkflowData(fmt.Sprintf("%s answered in %.2fs", event.Member, event.Duration.Seconds())))
+	}
+}
+
+// EndCIGroup closes the log group of the last phase, once the council is done
+func (p *Printer) EndCIGroup() {
+	p.ciMu.Lock()
+	defer p.ciMu.Unlock()
+	if p.ciGroup {
+		fmt.Fprintln(p.out, "::endgroup::")
+		p.ciGroup = false
+	}
+}
+
+// escapeWorkflowData escapes the message of a workflow command
+func escapeWorkflowData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeWorkflowProperty escapes a property value of a workflow command
+func escapeWorkflowProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
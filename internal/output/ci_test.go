@@ -0,0 +1,80 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+func TestHandleEventGitHubGroups(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out, noSpinner: true, ci: CIFormatGitHub}
+
+	p.HandleEvent(council.Event{Type: council.EventPhaseStart, Phase: council.PhaseQuery, Count: 2})
+	p.HandleEvent(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "gpt-5.2", Model: "gpt-5.2"})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "gpt-5.2", Model: "gpt-5.2", Duration: 1500 * time.Millisecond})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "claude", Model: "claude", Err: errors.New("timed out:\nno reply")})
+	p.HandleEvent(council.Event{Type: council.EventPhaseStart, Phase: council.PhaseReview, Count: 1})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseReview, Member: "gpt-5.2", Model: "gpt-5.2"})
+	p.HandleEvent(council.Event{Type: council.EventPhaseStart, Phase: council.PhaseAggregation, Count: 1})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseAggregation, Member: "chair, v2", Model: "chair", Duration: time.Second})
+	p.EndCIGroup()
+	p.EndCIGroup() // Nothing left to close
+
+	var commands []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "::") {
+			commands = append(commands, line)
+		}
+	}
+	want := []string{
+		"::group::Querying models",
+		"::notice title=gpt-5.2::gpt-5.2 answered in 1.50s",
+		"::error title=claude::claude failed after 0.00s: timed out:%0Ano reply",
+		"::endgroup::",
+		"::group::Peer review",
+		"::endgroup::",
+		"::group::Aggregation",
+		"::notice title=chair%2C v2::chair, v2 answered in 1.00s",
+		"::endgroup::",
+	}
+	if strings.Join(commands, "\n") != strings.Join(want, "\n") {
+		t.Errorf("workflow commands:\n%s\nwant:\n%s", strings.Join(commands, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestHandleEventNoCIFormat(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out, noSpinner: true}
+	p.HandleEvent(council.Event{Type: council.EventPhaseStart, Phase: council.PhaseQuery})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "gpt-5.2", Model: "gpt-5.2"})
+	p.EndCIGroup()
+	if strings.Contains(out.String(), "::") {
+		t.Errorf("expected no workflow commands without --ci-format github, got:\n%s", out.String())
+	}
+}
+
+func TestResolveCIFormat(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "true")
+	if got, err := ResolveCIFormat(""); err != nil || got != CIFormatGitHub {
+		t.Errorf("ResolveCIFormat(\"\") in GitHub Actions = %q, %v; want github", got, err)
+	}
+	if got, err := ResolveCIFormat(CIFormatNone); err != nil || got != CIFormatNone {
+		t.Errorf("ResolveCIFormat(none) = %q, %v; want none", got, err)
+	}
+
+	t.Setenv("GITHUB_ACTIONS", "")
+	if got, err := ResolveCIFormat(""); err != nil || got != CIFormatNone {
+		t.Errorf("ResolveCIFormat(\"\") outside GitHub Actions = %q, %v; want none", got, err)
+	}
+	if got, err := ResolveCIFormat(CIFormatGitHub); err != nil || got != CIFormatGitHub {
+		t.Errorf("ResolveCIFormat(github) = %q, %v; want github", got, err)
+	}
+	if _, err := ResolveCIFormat("gitlab"); err == nil {
+		t.Error("expected an error for an unknown CI format")
+	}
+}
@@ -0,0 +1,107 @@
+package output
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// CSVHeader names the columns of the --csv-append timing log
+var CSVHeader = []string{
+	"timestamp", "question_hash", "models",
+	"query_seconds", "review_seconds", "critique_seconds", "aggregation_seconds",
+	"total_seconds", "succeeded", "consensus_winner",
+}
+
+// NewCSVRow returns the timing row of one run. Phases that did not run are
+// 0; a totalDuration of 0 leaves the total empty, for runs timed as a batch.
+func NewCSVRow(now time.Time, question string, result council.Result, totalDuration time.Duration) []string {
+	footer := NewFooter(result, totalDuration)
+	sum := sha256.Sum256([]byte(question))
+
+	models := make([]string, len(result.ModelResponses))
+	for i, resp := range result.ModelResponses {
+		models[i] = resp.Label()
+	}
+	total := ""
+	if totalDuration > 0 {
+		total = csvSeconds(footer.TotalSeconds)
+	}
+	winner := ""
+	if len(result.Consensus) > 0 {
+		winner = result.Consensus[0].Model
+	}
+	return []string{
+		now.UTC().Format(time.RFC3339),
+		hex.EncodeToString(sum[:])[:12],
+		strings.Join(models, ";"),
+		csvSeconds(footer.PhaseSeconds[council.PhaseQuery]),
+		csvSeconds(footer.PhaseSeconds[council.PhaseReview]),
+		csvSeconds(footer.PhaseSeconds[council.PhaseCritique]),
+		csvSeconds(footer.PhaseSeconds[council.PhaseAggregation]),
+		total,
+		strconv.Itoa(footer.Succeeded),
+		winner,
+	}
+}
+
+// csvSeconds formats seconds without trailing zeros, e.g. "1.5"
+func csvSeconds(s float64) string {
+	return strconv.FormatFloat(s, 'f', -1, 64)
+}
+
+// csvMu serializes appends within the process, e.g. the runs of --watch
+var csvMu sync.Mutex
+
+// AppendCSV appends rows to the CSV file at path, writing CSVHeader first
+// when the file is new or empty. The header is written only by the process
+// that creates the file (O_EXCL), and each call goes out in a single
+// append-mode write, so processes sharing the file do not interleave their
+// lines. An empty file made by another tool gets the header from whichever
+// process appends first.
+func AppendCSV(path string, rows ...[]string) error {
+	csvMu.Lock()
+	defer csvMu.Unlock()
+
+	header := false
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_APPEND|os.O_WRONLY, 0o644)
+	switch {
+	case err == nil:
+		header = true
+	case errors.Is(err, fs.ErrExist):
+		if file, err = os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644); err != nil {
+			return fmt.Errorf("failed to open CSV file: %w", err)
+		}
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return fmt.Errorf("failed to open CSV file: %w", err)
+		}
+		header = info.Size() == 0
+	default:
+		return fmt.Errorf("failed to open CSV file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if header {
+		_ = w.Write(CSVHeader)
+	}
+	_ = w.WriteAll(rows) // Writes to a buffer cannot fail
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to append to CSV file: %w", err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,109 @@
+package output
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestNewCSVRow(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := NewCSVRow(at, "What is Go?", council.Result{
+		ModelResponses: []copilot.Response{
+			{Model: "model-a", Content: "a", Duration: 2 * time.Second},
+			{Member: "critic", Model: "model-b", Error: errors.New("boom"), Duration: 3 * time.Second},
+		},
+		Reviews:             []council.Review{{ReviewerModel: "model-a"}},
+		ReviewDuration:      time.Second,
+		AggregationDuration: 1500 * time.Millisecond,
+		Consensus:           []council.ConsensusScore{{Model: "model-a", Score: 1}},
+	}, 5*time.Second)
+
+	want := "2026-01-02T03:04:05Z|" + row[1] + "|model-a;critic|3|1|0|1.5|5|1|model-a" // The hash is checked below
+	if got := strings.Join(row, "|"); got != want {
+		t.Errorf("row = %s\nwant  %s", got, want)
+	}
+
+	a := NewCSVRow(at, "What is Go?", council.Result{}, 0)
+	b := NewCSVRow(at, "What is Rust?", council.Result{}, 0)
+	if len(a[1]) != 12 || a[1] == b[1] {
+		t.Errorf("expected a short hash per question, got %q and %q", a[1], b[1])
+	}
+	if a[7] != "" {
+		t.Errorf("expected an empty total without a duration, got %q", a[7])
+	}
+}
+
+func TestAppendCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timings.csv")
+	if err := AppendCSV(path, []string{"a", "b, c"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := AppendCSV(path, []string{"d", "e"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join(CSVHeader, ",") + "\na,\"b, c\"\nd,e\n"
+	if string(data) != want {
+		t.Errorf("file = %q, want %q", data, want)
+	}
+}
+
+func TestAppendCSVExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.csv")
+	started := filepath.Join(dir, "started.csv")
+	if err := os.WriteFile(empty, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(started, []byte("x,y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string]string{
+		empty:   strings.Join(CSVHeader, ",") + "\na,b\n",
+		started: "x,y\na,b\n",
+	} {
+		if err := AppendCSV(path, []string{"a", "b"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if data, _ := os.ReadFile(path); string(data) != want {
+			t.Errorf("%s = %q, want %q", filepath.Base(path), data, want)
+		}
+	}
+}
+
+func TestAppendCSVConcurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timings.csv")
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = AppendCSV(path, []string{"run", strings.Repeat("x", 1000)})
+		}()
+	}
+	wg.Wait()
+
+	data, _ := os.ReadFile(path)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) != 21 || strings.Count(string(data), "timestamp") != 1 {
+		t.Fatalf("expected one header and 20 rows, got %d lines", len(lines))
+	}
+	for i, line := range lines[1:] {
+		if line != "run,"+strings.Repeat("x", 1000) {
+			t.Fatalf("row %d is interleaved: %.40s...", i+1, line)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package output
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+)
+
+// diffOp is the kind of a diff segment
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+// diffSegment is a run of words that are in both texts, or only in one
+type diffSegment struct {
+	op   diffOp
+	text string
+}
+
+// wordPattern splits text into words, each keeping the whitespace after it
+var wordPattern = regexp.MustCompile(`\S+\s*`)
+
+// maxDiffCells bounds the LCS table of diffWords, about 8 MB of ints
+const maxDiffCells = 1 << 20
+
+// diffWords computes a word-level diff from a to b using the longest common
+// subsequence of words. Whitespace is carried along but not compared. Past
+// maxDiffCells the differing middle is shown as removed and re-added whole.
+func diffWords(a, b string) []diffSegment {
+	wa := wordPattern.FindAllString(a, -1)
+	wb := wordPattern.FindAllString(b, -1)
+	same := func(i, j int) bool { return strings.TrimSpace(wa[i]) == strings.TrimSpace(wb[j]) }
+
+	var segments []diffSegment
+	add := func(op diffOp, word string) {
+		if n := len(segments); n > 0 && segments[n-1].op == op {
+			segments[n-1].text += word
+			return
+		}
+		segments = append(segments, diffSegment{op: op, text: word})
+	}
+
+	// The common prefix and suffix need no table
+	start := 0
+	for start < len(wa) && start < len(wb) && same(start, start) {
+		add(diffEqual, wb[start])
+		start++
+	}
+	endA, endB := len(wa), len(wb)
+	for endA > start && endB > start && same(endA-1, endB-1) {
+		endA--
+		endB--
+	}
+
+	i, j := start, start
+	if n, m := endA-start, endB-start; n*m <= maxDiffCells {
+		// lcs[x][y] is the length of the LCS of wa[start+x:endA] and wb[start+y:endB]
+		lcs := make([][]int, n+1)
+		for x := range lcs {
+			lcs[x] = make([]int, m+1)
+		}
+		for x := n - 1; x >= 0; x-- {
+			for y := m - 1; y >= 0; y-- {
+				if same(start+x, start+y) {
+					lcs[x][y] = lcs[x+1][y+1] + 1
+				} else {
+					lcs[x][y] = max(lcs[x+1][y], lcs[x][y+1])
+				}
+			}
+		}
+
+		for i < endA && j < endB {
+			switch {
+			case same(i, j):
+				add(diffEqual, wb[j])
+				i++
+				j++
+			case lcs[i-start+1][j-start] >= lcs[i-start][j-start+1]:
+				add(diffDelete, wa[i])
+				i++
+			default:
+				add(diffInsert, wb[j])
+				j++
+			}
+		}
+	}
+	for ; i < endA; i++ {
+		add(diffDelete, wa[i])
+	}
+	for ; j < endB; j++ {
+		add(diffInsert, wb[j])
+	}
+	for ; j < len(wb); j++ {
+		add(diffEqual, wb[j])
+	}
+	return segments
+}
+
+// sharedWords returns the fraction of words the two sides have in common
+func sharedWords(segments []diffSegment) float64 {
+	var equal, deleted, inserted int
+	for _, segment := range segments {
+		n := len(wordPattern.FindAllString(segment.text, -1))
+		switch segment.op {
+		case diffEqual:
+			equal += n
+		case diffDelete:
+			deleted += n
+		case diffInsert:
+			inserted += n
+		}
+	}
+	if longest := equal + max(deleted, inserted); longest > 0 {
+		return float64(equal) / float64(longest)
+	}
+	return 1
+}
+
+// PrintDiff prints a word-level diff between the first two answers: words
+// only in the first are shown as [-removed-], words only in the second as
+// {+added+}. If just one member answered, its answer is shown instead.
+func (p *Printer) PrintDiff(responses []copilot.Response) {
+	var answered []copilot.Response
+	for _, resp := range responses {
+		if resp.Error == nil && resp.Content != "" {
+			answered = append(answered, resp)
+		}
+	}
+
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🔀 ANSWER DIFF                                         ║", "ANSWER DIFF"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+
+	switch len(answered) {
+	case 0:
+		return
+	case 1:
+		warningColor.Fprintf(p.out, "%s\n\n", fmt.Sprintf(p.tr("Only %s answered; nothing to compare."), answered[0].Label()))
+		fmt.Fprintln(p.out, answered[0].Content)
+		fmt.Fprintln(p.out)
+		return
+	}
+
+	a, b := answered[0], answered[1]
+	segments := diffWords(a.Content, b.Content)
+	errorColor.Fprintf(p.out, "  [-%s-]\n", a.Label())
+	successColor.Fprintf(p.out, "  {+%s+}\n", b.Label())
+	dimColor.Fprintf(p.out, "  %s\n\n", fmt.Sprintf(p.tr("%.0f%% of words shared"), sharedWords(segments)*100))
+
+	for _, segment := range segments {
+		switch segment.op {
+		case diffEqual:
+			fmt.Fprint(p.out, segment.text)
+		case diffDelete:
+			errorColor.Fprint(p.out, markSegment("[-", "-]", segment.text))
+		case diffInsert:
+			successColor.Fprint(p.out, markSegment("{+", "+}", segment.text))
+		}
+	}
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out)
+}
+
+// markSegment wraps text in the open and close markers, keeping its trailing
+// whitespace outside them
+func markSegment(open, close, text string) string {
+	trimmed := strings.TrimRight(text, " \t\r\n")
+	return open + trimmed + close + text[len(trimmed):]
+}
@@ -0,0 +1,82 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/openjny/council/internal/copilot"
+)
+
+func TestDiffWords(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"identical", "use a mutex", "use a mutex", "=use a mutex"},
+		{"changed word", "use a mutex here", "use a channel here", "=use a |-mutex |+channel |=here"},
+		{"appended", "go fmt", "go fmt ./...", "=go fmt |+./..."},
+		{"whitespace only", "one  two\nthree", "one two three", "=one two three"},
+		{"empty side", "", "new text", "+new text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var parts []string
+			for _, segment := range diffWords(tt.a, tt.b) {
+				parts = append(parts, string("=-+"[segment.op])+segment.text)
+			}
+			if got := strings.Join(parts, "|"); got != tt.want {
+				t.Errorf("diffWords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffWordsLargeInput(t *testing.T) {
+	// Two 2000-word answers that differ throughout would need a 4M-cell table
+	a := strings.Repeat("alpha beta ", 1000)
+	b := strings.Repeat("gamma delta ", 1000)
+	segments := diffWords("intro "+a+"outro", "intro "+b+"outro")
+
+	var parts []string
+	for _, segment := range segments {
+		parts = append(parts, string("=-+"[segment.op]))
+	}
+	if got := strings.Join(parts, ""); got != "=-+=" {
+		t.Fatalf("diffWords() ops = %q, want the middle removed and re-added whole", got)
+	}
+	if segments[1].text != a || segments[2].text != b {
+		t.Errorf("diffWords() middle = %q / %q", segments[1].text[:20], segments[2].text[:20])
+	}
+}
+
+func TestPrintDiff(t *testing.T) {
+	defer func(noColor bool) { color.NoColor = noColor }(color.NoColor)
+	color.NoColor = true
+
+	var out bytes.Buffer
+	p := &Printer{out: &out}
+	p.PrintDiff([]copilot.Response{
+		{Model: "model-a", Content: "Use a mutex here."},
+		{Model: "model-b", Content: "Use a channel here."},
+	})
+	if !strings.Contains(out.String(), "Use a [-mutex-] {+channel+} here.") {
+		t.Errorf("expected inline markers, got %q", out.String())
+	}
+	if !strings.Contains(out.String(), "[-model-a-]") || !strings.Contains(out.String(), "{+model-b+}") {
+		t.Errorf("expected a legend naming both models, got %q", out.String())
+	}
+
+	out.Reset()
+	p.PrintDiff([]copilot.Response{
+		{Model: "model-a", Error: errors.New("timeout")},
+		{Model: "model-b", Content: "Only answer."},
+	})
+	if !strings.Contains(out.String(), "Only model-b answered") || !strings.Contains(out.String(), "Only answer.") {
+		t.Errorf("expected the lone answer with a note, got %q", out.String())
+	}
+}
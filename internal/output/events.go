@@ -0,0 +1,76 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// EventRecord is one line of the NDJSON event stream written by --events-file
+type EventRecord struct {
+	Type            council.EventType `json:"type"`
+	Time            time.Time         `json:"time"`
+	Phase           string            `json:"phase,omitempty"`
+	Member          string            `json:"member,omitempty"`
+	Model           string            `json:"model,omitempty"`
+	Attempt         int               `json:"attempt,omitempty"`
+	Count           int               `json:"count,omitempty"`
+	Prompt          string            `json:"prompt,omitempty"`
+	Content         string            `json:"content,omitempty"`
+	DurationSeconds float64           `json:"duration_seconds,omitempty"`
+	TTFTSeconds     float64           `json:"ttft_seconds,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// NewEventRecord converts an event for the event stream
+func NewEventRecord(event council.Event) EventRecord {
+	record := EventRecord{
+		Type:            event.Type,
+		Time:            event.Time,
+		Phase:           event.Phase,
+		Member:          event.Member,
+		Model:           event.Model,
+		Attempt:         event.Attempt,
+		Count:           event.Count,
+		Prompt:          event.Prompt,
+		Content:         event.Content,
+		DurationSeconds: event.Duration.Seconds(),
+		TTFTSeconds:     event.TTFT.Seconds(),
+	}
+	if event.Err != nil {
+		record.Error = event.Err.Error()
+	}
+	return record
+}
+
+// EventWriter writes council events as NDJSON, one complete line per
+// event, so another process can tail the stream while the run goes on
+type EventWriter struct {
+	mu  sync.Mutex // Events arrive from many goroutines
+	enc *json.Encoder
+	err error // First write error; later events are dropped
+}
+
+// NewEventWriter creates an EventWriter writing to w
+func NewEventWriter(w io.Writer) *EventWriter {
+	return &EventWriter{enc: json.NewEncoder(w)}
+}
+
+// Handle writes event; it is a council.EventHandler
+func (e *EventWriter) Handle(event council.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err == nil {
+		e.err = e.enc.Encode(NewEventRecord(event))
+	}
+}
+
+// Err returns the first error writing the stream, if any
+func (e *EventWriter) Err() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
@@ -0,0 +1,87 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+func TestEventWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := NewEventWriter(&out)
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	w.Handle(council.Event{Type: council.EventPhaseStart, Time: at, Phase: council.PhaseQuery, Count: 2, Prompt: "q"})
+	w.Handle(council.Event{Type: council.EventFinished, Time: at, Phase: council.PhaseQuery, Member: "critic", Model: "gpt-5.2", Content: "Answer\nwith two lines", Duration: 1500 * time.Millisecond})
+	w.Handle(council.Event{Type: council.EventFinished, Time: at, Phase: council.PhaseReview, Member: "claude", Model: "claude", Attempt: 1, Err: errors.New("timed out")})
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected one line per event, got %d:\n%s", len(lines), out.String())
+	}
+	if want := `{"type":"phase_start","time":"2026-01-02T03:04:05Z","phase":"query","count":2,"prompt":"q"}`; lines[0] != want {
+		t.Errorf("line 1 = %s, want %s", lines[0], want)
+	}
+
+	var finished EventRecord
+	if err := json.Unmarshal([]byte(lines[1]), &finished); err != nil {
+		t.Fatalf("line 2 is not JSON: %v", err)
+	}
+	if finished.Member != "critic" || finished.Model != "gpt-5.2" || finished.Content != "Answer\nwith two lines" || finished.DurationSeconds != 1.5 {
+		t.Errorf("unexpected finished record: %+v", finished)
+	}
+
+	var failed EventRecord
+	if err := json.Unmarshal([]byte(lines[2]), &failed); err != nil {
+		t.Fatalf("line 3 is not JSON: %v", err)
+	}
+	if failed.Error != "timed out" || failed.Attempt != 1 || failed.Phase != council.PhaseReview {
+		t.Errorf("unexpected failed record: %+v", failed)
+	}
+	if w.Err() != nil {
+		t.Errorf("unexpected write error: %v", w.Err())
+	}
+}
+
+func TestEventWriterConcurrent(t *testing.T) {
+	var out bytes.Buffer
+	w := NewEventWriter(&out)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.Handle(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "model", Content: strings.Repeat("x", 1000)})
+		}()
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 50 {
+		t.Fatalf("expected 50 lines, got %d", len(lines))
+	}
+	for i, line := range lines {
+		if !json.Valid([]byte(line)) {
+			t.Fatalf("line %d is not a complete JSON object: %.40s...", i+1, line)
+		}
+	}
+}
+
+// failingWriter fails every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestEventWriterError(t *testing.T) {
+	w := NewEventWriter(failingWriter{})
+	w.Handle(council.Event{Type: council.EventStarted})
+	w.Handle(council.Event{Type: council.EventFinished})
+	if err := w.Err(); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("expected the write error, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// FooterPrefix starts the --json-footer line, so scripts can find it with
+// `tail -1` or grep
+const FooterPrefix = "COUNCIL_SUMMARY: "
+
+// Footer is the machine-readable digest of a run printed by PrintJSONFooter
+type Footer struct {
+	Models         int                `json:"models"`
+	Succeeded      int                `json:"succeeded"`
+	PhaseSeconds   map[string]float64 `json:"phase_seconds"`
+	TotalSeconds   float64            `json:"total_seconds"`
+	ConsensusScore *float64           `json:"consensus_score"` // Top consensus score; null without peer review
+	AnswerChars    int                `json:"answer_chars"`
+	Error          string             `json:"error,omitempty"`
+}
+
+// NewFooter summarizes a run for PrintJSONFooter
+func NewFooter(result council.Result, totalDuration time.Duration) Footer {
+	stats := council.Stats(result)
+	footer := Footer{
+		Models:       stats.Query.Total,
+		Succeeded:    stats.Query.Succeeded,
+		PhaseSeconds: make(map[string]float64),
+		TotalSeconds: roundSeconds(totalDuration),
+		AnswerChars:  len([]rune(result.AggregatedResponse)),
+	}
+	footer.PhaseSeconds[council.PhaseQuery] = roundSeconds(stats.QueryDuration)
+	if len(result.Reviews) > 0 {
+		footer.PhaseSeconds[council.PhaseReview] = roundSeconds(result.ReviewDuration)
+	}
+	if len(result.Critiques) > 0 {
+		footer.PhaseSeconds[council.PhaseCritique] = roundSeconds(result.CritiqueDuration)
+	}
+	if result.AggregationDuration > 0 {
+		footer.PhaseSeconds[council.PhaseAggregation] = roundSeconds(result.AggregationDuration)
+	}
+	if len(result.Consensus) > 0 {
+		score := result.Consensus[0].Score
+		footer.ConsensusScore = &score
+	}
+	if result.Error != nil {
+		footer.Error = result.Error.Error()
+	}
+	return footer
+}
+
+// roundSeconds converts d to seconds with millisecond precision
+func roundSeconds(d time.Duration) float64 {
+	return d.Round(time.Millisecond).Seconds()
+}
+
+// PrintJSONFooter prints the run's key stats as a single JSON line after
+// FooterPrefix. It goes to stdout with the answer, even in quiet mode.
+func (p *Printer) PrintJSONFooter(result council.Result, totalDuration time.Duration) {
+	data, err := json.Marshal(NewFooter(result, totalDuration))
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(p.answerOut, "%s%s\n", FooterPrefix, data)
+}
@@ -0,0 +1,47 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestPrintJSONFooter(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &bytes.Buffer{}, answerOut: &out}
+	p.PrintJSONFooter(council.Result{
+		ModelResponses: []copilot.Response{
+			{Model: "model-a", Content: "a", Duration: 2 * time.Second},
+			{Model: "model-b", Error: errors.New("boom"), Duration: 3 * time.Second},
+		},
+		Reviews:             []council.Review{{ReviewerModel: "model-a"}},
+		ReviewDuration:      time.Second,
+		AggregationDuration: 1500 * time.Millisecond,
+		Consensus:           []council.ConsensusScore{{Model: "model-a", Score: 0.75}},
+		AggregatedResponse:  "Go is a language.",
+	}, 5*time.Second)
+
+	line := out.String()
+	if !strings.HasPrefix(line, FooterPrefix) || strings.Count(line, "\n") != 1 {
+		t.Fatalf("expected a single prefixed line, got %q", line)
+	}
+	var footer Footer
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, FooterPrefix)), &footer); err != nil {
+		t.Fatalf("footer is not valid JSON: %v", err)
+	}
+	if footer.Models != 2 || footer.Succeeded != 1 || footer.AnswerChars != 17 || footer.TotalSeconds != 5 {
+		t.Errorf("unexpected footer: %+v", footer)
+	}
+	if footer.PhaseSeconds["query"] != 3 || footer.PhaseSeconds["review"] != 1 || footer.PhaseSeconds["aggregation"] != 1.5 {
+		t.Errorf("unexpected phase durations: %v", footer.PhaseSeconds)
+	}
+	if footer.ConsensusScore == nil || *footer.ConsensusScore != 0.75 {
+		t.Errorf("unexpected consensus score: %v", footer.ConsensusScore)
+	}
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+// PrintGathered prints the outcome of a gather-mode run: every answer, best
+// ranked first, and the peer review ranking, in place of a final answer.
+// Verbose mode already showed the answers as they arrived, so only the
+// ranking follows. In quiet mode the answers are the output, as Markdown.
+func (p *Printer) PrintGathered(result council.Result) {
+	answers := rankedAnswers(result)
+	if p.quiet {
+		for i, resp := range answers {
+			if i > 0 {
+				fmt.Fprint(p.answerOut, "\n\n")
+			}
+			fmt.Fprintf(p.answerOut, "## %s\n\n%s", resp.Label(), strings.TrimSpace(resp.Content))
+		}
+		if p.newline {
+			fmt.Fprintln(p.answerOut)
+		}
+		return
+	}
+
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 📚 COUNCIL ANSWERS                                     ║", "COUNCIL ANSWERS"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	if !p.verbose {
+		for _, resp := range answers {
+			p.PrintModelResponse(resp)
+		}
+	}
+
+	if len(result.Consensus) == 0 {
+		fmt.Fprintln(p.out)
+		return
+	}
+	fmt.Fprintln(p.out)
+	titleColor.Fprintln(p.out, p.tr("Peer review ranking:"))
+	for i, score := range result.Consensus {
+		fmt.Fprintf(p.out, "  %d. ", i+1)
+		memberColor(score.Model).Fprint(p.out, padRight(score.Model, 28))
+		dimColor.Fprintf(p.out, " %.2f (%s)\n", score.Score, fmt.Sprintf(p.tr("%d reviews"), score.Reviews))
+	}
+	fmt.Fprintln(p.out)
+}
+
+// rankedAnswers returns the answered responses in consensus order, followed
+// by any the reviewers did not rank
+func rankedAnswers(result council.Result) []copilot.Response {
+	byLabel := make(map[string]copilot.Response, len(result.ModelResponses))
+	for _, resp := range result.ModelResponses {
+		if resp.Error == nil && resp.Content != "" {
+			byLabel[resp.Label()] = resp
+		}
+	}
+
+	var answers []copilot.Response
+	for _, score := range result.Consensus {
+		if resp, ok := byLabel[score.Model]; ok {
+			answers = append(answers, resp)
+			delete(byLabel, score.Model)
+		}
+	}
+	for _, resp := range result.ModelResponses {
+		if _, ok := byLabel[resp.Label()]; ok {
+			answers = append(answers, resp)
+		}
+	}
+	return answers
+}
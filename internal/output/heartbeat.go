@@ -0,0 +1,102 @@
+package output
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// heartbeatInterval is how often a run without spinners reports that it is
+// still waiting, so a long CI or piped run does not look hung
+const heartbeatInterval = 20 * time.Second
+
+// printWaitEstimate tells a run without spinners how many models are being
+// asked and how long that may take, since nothing else prints until the
+// first one answers
+func (p *Printer) printWaitEstimate(models int) {
+	if !p.noSpinner {
+		return
+	}
+	if p.timeout > 0 {
+		dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("Querying %d models; this may take up to %ds (see --timeout)..."), models, int(p.timeout.Seconds())))
+		return
+	}
+	dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("Querying %d models..."), models))
+}
+
+// trackWaiting keeps track of the requests in flight and keeps a heartbeat
+// running while there are any. Requests are matched by waitKey, so a finish
+// without a start is ignored. A new phase starts over, as not every
+// cancelled request reports back.
+func (p *Printer) trackWaiting(event council.Event) {
+	if !p.noSpinner || p.beatEvery <= 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch event.Type {
+	case council.EventPhaseStart:
+		p.pending = nil
+	case council.EventStarted:
+		if p.pending == nil {
+			p.pending = make(map[string]bool)
+		}
+		p.pending[waitKey(event)] = true
+	case council.EventFinished:
+		delete(p.pending, waitKey(event))
+	default:
+		return
+	}
+	switch {
+	case len(p.pending) > 0 && p.stopBeat == nil:
+		p.stopBeat = make(chan struct{})
+		go p.heartbeat(p.stopBeat, time.Now())
+	case len(p.pending) == 0 && p.stopBeat != nil:
+		close(p.stopBeat)
+		p.stopBeat = nil
+	}
+}
+
+// waitKey identifies the request an EventStarted or EventFinished is about
+func waitKey(event council.Event) string {
+	return fmt.Sprintf("%s/%s/%d/%d", event.Phase, event.Member, event.Attempt, event.Count)
+}
+
+// heartbeat prints how long the requests in flight have been waiting, every
+// beatEvery, until stop is closed
+func (p *Printer) heartbeat(stop <-chan struct{}, start time.Time) {
+	ticks, stopTicks := p.startBeatTicker()
+	defer stopTicks()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticks:
+			p.mu.Lock()
+			pending := len(p.pending)
+			p.mu.Unlock()
+			dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("Still waiting for %d request(s), %ds elapsed..."), pending, int(now.Sub(start).Round(time.Second).Seconds())))
+		}
+	}
+}
+
+// startBeatTicker starts the ticks of the heartbeat
+func (p *Printer) startBeatTicker() (<-chan time.Time, func()) {
+	if p.beatTicker != nil {
+		return p.beatTicker(p.beatEvery)
+	}
+	ticker := time.NewTicker(p.beatEvery)
+	return ticker.C, ticker.Stop
+}
+
+// StopHeartbeat stops the heartbeat once the council is done
+func (p *Printer) StopHeartbeat() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopBeat != nil {
+		close(p.stopBeat)
+		p.stopBeat = nil
+	}
+	p.pending = nil
+}
@@ -0,0 +1,101 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// lockedBuffer is a bytes.Buffer safe to write from the heartbeat goroutine
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// fakeTicker hands the heartbeat ticks sent by the test, and reports when
+// the heartbeat stops
+type fakeTicker struct {
+	ticks   chan time.Time
+	stopped chan struct{}
+}
+
+func newFakeTicker() *fakeTicker {
+	return &fakeTicker{ticks: make(chan time.Time), stopped: make(chan struct{})}
+}
+
+func (f *fakeTicker) start(time.Duration) (<-chan time.Time, func()) {
+	return f.ticks, func() { close(f.stopped) }
+}
+
+// tick delivers a tick; once it returns, the tick before it has been printed
+func (f *fakeTicker) tick(t *testing.T, now time.Time) {
+	select {
+	case f.ticks <- now:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the heartbeat is not running")
+	}
+}
+
+func TestHeartbeatWithoutSpinners(t *testing.T) {
+	var out lockedBuffer
+	ticker := newFakeTicker()
+	p := &Printer{out: &out, noSpinner: true, timeout: 2 * time.Minute, beatEvery: time.Second, beatTicker: ticker.start}
+
+	p.HandleEvent(council.Event{Type: council.EventPhaseStart, Phase: council.PhaseQuery, Count: 2})
+	if !strings.Contains(out.String(), "Querying 2 models; this may take up to 120s") {
+		t.Errorf("expected the wait estimate, got:\n%s", out.String())
+	}
+	p.HandleEvent(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "model-a"})
+	p.HandleEvent(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "model-b"})
+	ticker.tick(t, time.Now().Add(20*time.Second))
+	ticker.tick(t, time.Now().Add(40*time.Second))
+	if !strings.Contains(out.String(), "Still waiting for 2 request(s), 20s elapsed") {
+		t.Errorf("expected a heartbeat, got:\n%s", out.String())
+	}
+
+	// A finish without a start, or a repeated one, does not hide a request in flight
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseReview, Member: "model-a"})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-a"})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-a"})
+	ticker.tick(t, time.Now().Add(60*time.Second))
+	ticker.tick(t, time.Now().Add(80*time.Second))
+	if !strings.Contains(out.String(), "Still waiting for 1 request(s), 60s elapsed") {
+		t.Errorf("expected model-b to be still waited for, got:\n%s", out.String())
+	}
+
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-b"})
+	select {
+	case <-ticker.stopped:
+	case <-time.After(5 * time.Second):
+		t.Error("expected the heartbeat to stop once every request finished")
+	}
+}
+
+func TestHeartbeatWithSpinners(t *testing.T) {
+	var out lockedBuffer
+	p := &Printer{out: &out, beatEvery: time.Second, beatTicker: func(time.Duration) (<-chan time.Time, func()) {
+		t.Error("no heartbeat should start while spinners run")
+		return nil, func() {}
+	}}
+	p.printWaitEstimate(2)
+	p.trackWaiting(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "model-a"})
+	if out.String() != "" || p.stopBeat != nil {
+		t.Errorf("expected no progress lines while spinners run, got %q", out.String())
+	}
+}
@@ -0,0 +1,267 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// Languages for the printer's own text; model output is never translated
+const (
+	LangEnglish  = "en"
+	LangJapanese = "ja"
+)
+
+// Languages lists the supported languages, default first
+var Languages = []string{LangEnglish, LangJapanese}
+
+// catalog translates the printer's English strings, which double as message
+// keys. Missing entries fall back to English.
+var catalog = map[string]map[string]string{
+	LangJapanese: {
+		// Banners and section titles
+		"Council - AI Model Council":     "Council - AI モデル評議会",
+		"Multi-Model Answer Synthesis":   "マルチモデル回答統合",
+		"Querying models in parallel...": "モデルに並列で問い合わせ中...",
+		"Conducting peer review...":      "相互レビュー中...",
+		"Revising answers together...":   "回答を協力して修正中...",
+		"Critiquing the proposal...":     "提案を批評中...",
+		"Summarizing in groups...":       "グループごとに要約中...",
+		"Synthesizing responses...":      "回答を統合中...",
+		"Warming up %d sessions...":      "%d 件のセッションを準備中...",
+		"First token after %.2fs":        "最初のトークンまで %.2fs",
+		"%d words":                       "%d 語",
+		"positive":                       "肯定的",
+		"negative":                       "否定的",
+		"neutral":                        "中立",
+		"FINAL ANSWER":                   "最終回答",
+		"DISSENTING OPINIONS":            "反対意見",
+		"CLAIM CHECK":                    "主張の検証",
+		"EXECUTION SUMMARY":              "実行サマリー",
+		"PEER REVIEW RESULTS":            "相互レビュー結果",
+		"GROUP SUMMARIES":                "グループ要約",
+		"ERROR":                          "エラー",
+		"ANSWER DIFF":                    "回答の差分",
+		"COUNCIL ANSWERS":                "評議会の回答",
+		"CANDIDATE ANSWERS":              "回答候補",
+		"STRATEGY COMPARISON":            "集約方式の比較",
+		"Stage 1: Initial Responses":     "ステージ1: 初回回答",
+		"Stage 2: Peer Review":           "ステージ2: 相互レビュー",
+		"Stage 2: Roundtable":            "ステージ2: 円卓",
+		"Stage 2: Critique":              "ステージ2: 批評",
+		"Stage 3: Final Synthesis":       "ステージ3: 最終統合",
+		"Stage 3: Field Vote":            "ステージ3: 項目ごとの投票",
+		"Refinement":                     "改善ラウンド",
+		"Refinement round":               "改善ラウンド No.",
+
+		// Summary and error box labels
+		"Models queried:":       "問い合わせ:",
+		"Failed:":               "失敗:",
+		"Incomplete:":           "未完了:",
+		"Refused:":              "回答拒否:",
+		"Viewpoints:":           "異なる見解:",
+		"Proposal from:":        "提案者:",
+		"Critiques:":            "批評:",
+		"Retries:":              "再試行:",
+		"Council favorite:":     "評議会の支持:",
+		"Top pick:":             "最多支持:",
+		"Tied for first:":       "1位が同数:",
+		"Tiebreak:":             "決選:",
+		"Synthesis:":            "統合:",
+		"Fastest:":              "最速:",
+		"Phase time:":           "所要時間:",
+		"Reviews completed:":    "完了レビュー:",
+		"Reviews skipped:":      "省略レビュー:",
+		"Reviewers:":            "レビュアー:",
+		"Review pairs:":         "レビュー組:",
+		"Top ranked:":           "最高評価:",
+		"Skipped:":              "省略:",
+		"Answer from:":          "回答元:",
+		"Chairman:":             "議長:",
+		"Synthesizer:":          "統合担当:",
+		"Echo:":                 "類似:",
+		"Rounds:":               "ラウンド:",
+		"Revised:":              "修正:",
+		"Length:":               "長さ:",
+		"Abstained:":            "レビュー辞退:",
+		"Not reviewing:":        "レビュー対象外:",
+		"Conforming:":           "スキーマ適合:",
+		"Non-conforming:":       "スキーマ不適合:",
+		"Fields:":               "項目:",
+		"Warmup:":               "ウォームアップ:",
+		"Total execution time:": "合計実行時間:",
+		"Model:":                "モデル:",
+		"Issue:":                "問題:",
+		"Duration:":             "所要時間:",
+		"Status:":               "ステータス:",
+		"Request ID:":           "要求ID:",
+		"Suggestion:":           "対処:",
+
+		// Diff mode
+		"Only %s answered; nothing to compare.": "%s のみが回答したため比較できません。",
+		"%.0f%% of words shared":                "単語の %.0f%% が共通",
+
+		// Truncated responses
+		"… (%d more lines; --verbose shows them, --also-markdown or --responses-dir saves them)": "… (残り %d 行。--verbose で全文を表示、--also-markdown か --responses-dir で保存できます)",
+
+		// Progress without spinners
+		"Querying %d models; this may take up to %ds (see --timeout)...": "%d 個のモデルに問い合わせ中。最大 %d 秒かかる場合があります (--timeout で変更)...",
+		"Querying %d models...":                           "%d 個のモデルに問い合わせ中...",
+		"Still waiting for %d request(s), %ds elapsed...": "%d 件の応答を待機中、%d 秒経過...",
+
+		// Skipping slow members
+		"Press s to stop waiting for the models still answering": "s キーでまだ回答中のモデルを待たずに進みます",
+
+		// Gather mode
+		"Peer review ranking:": "相互レビューの順位:",
+		"%d reviews":           "レビュー %d 件",
+
+		// Claim check
+		"All %d claims are supported by a member's answer":          "%d 件の主張すべてがメンバーの回答に裏付けられています",
+		"%d of %d claims are not supported by any member's answer:": "%d/%d 件の主張はどのメンバーの回答にも裏付けがありません:",
+
+		// Synthesis
+		"Aggregator: %s":             "集約モデル: %s",
+		"Analyzing: %d responses":    "分析対象: %d 件の回答",
+		"Processing...":              "処理中...",
+		"Synthesis complete (%.2fs)": "統合完了 (%.2fs)",
+		"Answer from %s":             "回答元: %s",
+
+		// Skip reasons (council.Skip* and the cache)
+		council.SkipSingleSuccess: "単一モデルの回答を採用",
+		council.SkipUnanimous:     "全会一致のため統合を省略",
+		SkipCached:                "キャッシュ済みの統合を再利用 — 集約モデルは未実行",
+
+		// Tiebreak
+		"Top two responses tied, asking the tiebreaker...": "上位 2 件が同点のため、決選を依頼中...",
+
+		// Partial responses
+		"(incomplete)": "(未完了)",
+
+		// Watch mode
+		"Watching %s for changes (Ctrl-C to exit)...": "%s の変更を監視中 (Ctrl-C で終了)...",
+
+		// Multi-prompt runs
+		"Question":               "質問",
+		"Questions":              "質問",
+		"Merging the answers...": "回答を統合中...",
+		"Meta-Aggregation":       "メタ統合",
+		"Answered:":              "回答済み:",
+
+		// Error suggestions
+		"Try --timeout 120":                       "--timeout 120 を試してください",
+		"Wait and retry, or use fewer models":     "時間をおいて再試行かモデルを減らす",
+		"Run 'copilot --help' for names":          "'copilot --help' で名前を確認",
+		"Try --retry-empty":                       "--retry-empty を試してください",
+		"Run 'gh auth login'; check subscription": "'gh auth login' と契約を確認",
+		"Check Copilot CLI is installed":          "Copilot CLI のインストールを確認",
+	},
+}
+
+// DetectLanguage resolves the output language from an explicit choice, or
+// from LC_ALL, LC_MESSAGES and LANG (e.g. ja_JP.UTF-8) when lang is empty
+func DetectLanguage(lang string) (string, error) {
+	if lang != "" {
+		lang = strings.ToLower(lang)
+		for _, supported := range Languages {
+			if lang == supported {
+				return lang, nil
+			}
+		}
+		return "", fmt.Errorf("unsupported language %q: expected one of %s", lang, strings.Join(Languages, ", "))
+	}
+
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(env); value != "" {
+			if strings.HasPrefix(strings.ToLower(value), LangJapanese) {
+				return LangJapanese, nil
+			}
+			return LangEnglish, nil
+		}
+	}
+	return LangEnglish, nil
+}
+
+// tr translates an English string into the printer's language
+func (p *Printer) tr(s string) string {
+	if translated, ok := catalog[p.lang][s]; ok {
+		return translated
+	}
+	return s
+}
+
+// neutralPhrases hold the plain terms that replace the printer's council
+// phrases with the neutral framing; translations key on the plain terms
+var neutralPhrases = map[string]string{
+	"Council - AI Model Council": "Multi-Model Answer Synthesis",
+	"COUNCIL ANSWERS":            "CANDIDATE ANSWERS",
+	"Council favorite:":          "Top pick:",
+	"Chairman:":                  "Synthesizer:",
+	"CHAIRMAN'S REASONING":       "SYNTHESIZER'S REASONING",
+	"All Council Models":         "All Models",
+	"the chairman":               "the synthesizer",
+}
+
+// frame returns phrase in the printer's framing: its plain term from
+// neutralPhrases with the neutral framing, or phrase itself
+func (p *Printer) frame(phrase string) string {
+	if neutral, ok := neutralPhrases[phrase]; ok && p.neutral {
+		return neutral
+	}
+	return phrase
+}
+
+// localize frames and translates phrase inside a fixed-width box line,
+// growing or shrinking the run of spaces after it so the box stays aligned
+func (p *Printer) localize(line, phrase string) string {
+	if framed := p.frame(phrase); framed != phrase {
+		line, phrase = replacePhrase(line, phrase, framed), framed
+	}
+	return replacePhrase(line, phrase, p.tr(phrase))
+}
+
+// replacePhrase replaces phrase inside a fixed-width box line with
+// translated, keeping the box aligned
+func replacePhrase(line, phrase, translated string) string {
+	i := strings.Index(line, phrase)
+	if translated == phrase || i < 0 {
+		return line
+	}
+
+	rest := line[i+len(phrase):]
+	trimmed := strings.TrimLeft(rest, " ")
+	spaces := len(rest) - len(trimmed) - (displayWidth(translated) - displayWidth(phrase))
+	if spaces < 1 {
+		spaces = 1
+	}
+	return line[:i] + translated + strings.Repeat(" ", spaces) + trimmed
+}
+
+// displayWidth returns the terminal columns s occupies, counting East Asian
+// wide characters as two
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		// Han, Hangul, CJK punctuation, Hiragana, Katakana and fullwidth forms
+		case unicode.Is(unicode.Han, r), unicode.Is(unicode.Hangul, r),
+			r >= 0x3000 && r <= 0x30FF,
+			r >= 0xFF01 && r <= 0xFF60, r >= 0xFFE0 && r <= 0xFFE6:
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+// padRight pads s with spaces to width terminal columns
+func padRight(s string, width int) string {
+	if w := displayWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/council"
+)
+
+// PrintSubQuestionStart prints the start of one question of a multi-prompt run
+func (p *Printer) PrintSubQuestionStart(number int, question string) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintf(p.out, p.localize("║ ❓ Question %-42d ║\n", "Question"), number)
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	dimColor.Fprintf(p.out, "  %s\n", question)
+}
+
+// PrintMetaStart prints the start of the meta-aggregation of a multi-prompt run
+func (p *Printer) PrintMetaStart() {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🧩 Merging the answers...                              ║", "Merging the answers..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+}
+
+// PrintSubAnswers prints the council's answer to each question of a multi-prompt run
+func (p *Printer) PrintSubAnswers(questions []string, results []council.Result) {
+	for i, result := range results {
+		fmt.Fprintln(p.out)
+		fmt.Fprintln(p.out, "┌────────────────────────────────────────────────────────┐")
+		modelColor.Fprintf(p.out, "│ 📝 %-51s │\n", truncate(fmt.Sprintf("%d. %s", i+1, questions[i]), 51))
+		fmt.Fprintln(p.out, "└────────────────────────────────────────────────────────┘")
+		if result.Error != nil {
+			errorColor.Fprintf(p.out, "  ✗ %v\n", result.Error)
+			continue
+		}
+		fmt.Fprintln(p.out, result.AggregatedResponse)
+	}
+	fmt.Fprintln(p.out)
+}
+
+// PrintMultiSummary prints the execution summary of a multi-prompt run
+func (p *Printer) PrintMultiSummary(result council.Result, totalDuration time.Duration) {
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 📊 EXECUTION SUMMARY                                   ║", "EXECUTION SUMMARY"))
+	fmt.Fprintln(p.out, "╠════════════════════════════════════════════════════════╣")
+
+	var failed []string
+	for i, sub := range result.SubResults {
+		if sub.Error != nil {
+			failed = append(failed, fmt.Sprintf("#%d", i+1))
+		}
+	}
+	answered := fmt.Sprintf("%d/%d questions", len(result.SubResults)-len(failed), len(result.SubResults))
+
+	fmt.Fprintln(p.out, "║                                                        ║")
+	titleColor.Fprintln(p.out, p.localize("║ Questions                                              ║", "Questions"))
+	if len(failed) == 0 {
+		successColor.Fprintf(p.out, p.localize("║   Answered:          %-33s ║\n", "Answered:"), answered)
+	} else {
+		warningColor.Fprintf(p.out, p.localize("║   Answered:          %-33s ║\n", "Answered:"), answered)
+		warningColor.Fprintf(p.out, p.localize("║   Failed:            %-33s ║\n", "Failed:"), truncate(strings.Join(failed, ", "), 33))
+	}
+
+	if result.AggregationDuration > 0 {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Meta-Aggregation                                       ║", "Meta-Aggregation"))
+		fmt.Fprintf(p.out, p.localize("║   Chairman:          %-33s ║\n", "Chairman:"), truncate(result.AggregatorModel, 33))
+		fmt.Fprintf(p.out, p.localize("║   Phase time:        %-33s ║\n", "Phase time:"), fmt.Sprintf("%.2fs", result.AggregationDuration.Seconds()))
+	}
+
+	fmt.Fprintln(p.out, "║                                                        ║")
+	fmt.Fprintln(p.out, "╠════════════════════════════════════════════════════════╣")
+	fmt.Fprintf(p.out, p.localize("║ Total execution time: %-32s ║\n", "Total execution time:"), fmt.Sprintf("%.2fs", totalDuration.Seconds()))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+}
@@ -0,0 +1,84 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultPager is used when $PAGER is not set
+const DefaultPager = "less"
+
+// PagerCommand returns the pager to run: $PAGER, or DefaultPager
+func PagerCommand() string {
+	if pager := strings.TrimSpace(os.Getenv("PAGER")); pager != "" {
+		return pager
+	}
+	return DefaultPager
+}
+
+// StartPaging collects everything printed to stdout from now on until
+// FlushPager, so a long run can be shown through a pager. It does nothing
+// when stdout is not a terminal or in quiet mode, where the output is
+// meant for another program. Spinners, warnings and errors go to stderr and
+// still show up while the council runs.
+func (p *Printer) StartPaging() {
+	if !p.isTerminal || p.quiet || p.paged != nil {
+		return
+	}
+	p.paged = &bytes.Buffer{}
+	p.out = p.paged
+	p.answerOut = p.paged
+}
+
+// FlushPager writes the output collected since StartPaging. Output that
+// fits on the screen is printed as is; longer output is piped through
+// command (run by the shell, like git does) with LESS defaulting to FRX so
+// colors survive. If the pager cannot run, the output is printed directly
+// and the error returned.
+func (p *Printer) FlushPager(command string) error {
+	if p.paged == nil {
+		return nil
+	}
+	collected := p.paged.Bytes()
+	p.paged = nil
+	p.out = os.Stdout
+	p.answerOut = os.Stdout
+
+	if command == "" || command == "cat" || fitsScreen(collected) {
+		_, err := os.Stdout.Write(collected)
+		return err
+	}
+
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdin = bytes.NewReader(collected)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if os.Getenv("LESS") == "" {
+		cmd.Env = append(cmd.Env, "LESS=FRX")
+	}
+	if err := cmd.Run(); err != nil {
+		// The shell exits with 127 when the pager is not installed; anything
+		// else means the pager ran and already showed the output
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() == 127 {
+			os.Stdout.Write(collected)
+		}
+		return fmt.Errorf("pager %q failed: %w", command, err)
+	}
+	return nil
+}
+
+// fitsScreen reports whether output fits in the terminal's height, so the
+// pager can be skipped
+func fitsScreen(output []byte) bool {
+	height := terminalHeight()
+	if height <= 0 {
+		return false
+	}
+	return bytes.Count(output, []byte("\n")) < height
+}
@@ -0,0 +1,55 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPager(t *testing.T) {
+	paged := filepath.Join(t.TempDir(), "paged.txt")
+
+	p := &Printer{out: &bytes.Buffer{}, answerOut: &bytes.Buffer{}, isTerminal: true}
+	p.StartPaging()
+	p.PrintBlankLine()
+	p.PrintFinalResult("The answer")
+	if err := p.FlushPager("cat > " + paged); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(paged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte("The answer")) {
+		t.Errorf("expected the answer to reach the pager, got %q", data)
+	}
+	if p.paged != nil {
+		t.Error("FlushPager should stop paging")
+	}
+}
+
+func TestPagerSkippedWhenPiped(t *testing.T) {
+	var out bytes.Buffer
+	for _, p := range []*Printer{
+		{out: &out, answerOut: &out},
+		{out: &out, answerOut: &out, isTerminal: true, quiet: true},
+	} {
+		p.StartPaging()
+		if p.paged != nil {
+			t.Error("paging should be off when stdout is not a terminal or in quiet mode")
+		}
+	}
+}
+
+func TestPagerCommand(t *testing.T) {
+	t.Setenv("PAGER", "")
+	if got := PagerCommand(); got != DefaultPager {
+		t.Errorf("PagerCommand() = %q, want %q", got, DefaultPager)
+	}
+	t.Setenv("PAGER", "more")
+	if got := PagerCommand(); got != "more" {
+		t.Errorf("PagerCommand() = %q, want more", got)
+	}
+}
@@ -1,89 +1,246 @@
 package output
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/briandowns/spinner"
-	"github.com/fatih/color"
 	"github.com/openjny/council/internal/copilot"
 	"github.com/openjny/council/internal/council"
 	"golang.org/x/term"
 )
 
-var (
-	// Colors
-	titleColor   = color.New(color.FgCyan, color.Bold)
-	modelColor   = color.New(color.FgGreen, color.Bold)
-	errorColor   = color.New(color.FgRed)
-	successColor = color.New(color.FgGreen)
-	dimColor     = color.New(color.Faint)
-	warningColor = color.New(color.FgYellow)
-)
-
 // Printer handles formatted output
 type Printer struct {
 	verbose    bool
+	quiet      bool
+	newline    bool       // Whether quiet mode ends the answer with a newline
+	out        io.Writer  // Decorations, progress, and verbose sections
+	answerOut  io.Writer  // The final answer
+	mu         sync.Mutex // Guards spinners; progress callbacks arrive from many goroutines
 	spinners   map[string]*spinner.Spinner
 	isTerminal bool
 	noSpinner  bool
+	lang       string // Language of the printer's own text; model output is never translated
+	noBanner   bool
+	maxLines   int           // Display lines shown per member response outside verbose mode; 0 shows everything
+	paged      *bytes.Buffer // Output held for the pager between StartPaging and FlushPager
+	skipHint   bool          // Whether pressing 's' skips the members still answering
+	ci         string        // CI log format (CIFormatGitHub adds workflow commands)
+	ciMu       sync.Mutex    // Guards ciGroup
+	ciGroup    bool          // Whether a CI log group is open
+	timeout    time.Duration // Per-request timeout, quoted when spinners are off
+
+	// Without spinners, a heartbeat line reports requests still in flight
+	beatEvery time.Duration
+	pending   map[string]bool // Requests started and not finished, by waitKey; guarded by mu
+	stopBeat  chan struct{}   // Stops the heartbeat; nil while none runs
+	analyze   bool            // Annotate answers printed live with their word count, language and tone
+	neutral   bool            // Plain terms instead of the council's; see neutralPhrases
+
+	// beatTicker starts the heartbeat's ticks; nil uses a time.Ticker
+	beatTicker func(time.Duration) (<-chan time.Time, func())
+}
+
+// Options configures a Printer
+type Options struct {
+	Verbose bool
+	// Quiet prints only the final answer to stdout; progress, warnings and errors go to stderr
+	Quiet bool
+	// NoTrailingNewline omits the newline after the answer in quiet mode
+	NoTrailingNewline bool
+	// Lang is the language of the printer's own text (see Languages); empty means English
+	Lang string
+	// NoBanner suppresses the banner and the echoed question
+	NoBanner bool
+	// MaxOutputLines cuts each printed member response after this many display lines, except in verbose mode; 0 means no limit
+	MaxOutputLines int
+	// CIFormat marks up progress for a CI log (see CIFormats); empty means CIFormatNone
+	CIFormat string
+	// Timeout is the per-request timeout, quoted in the progress lines printed without spinners
+	Timeout time.Duration
+	// AnalyzeResponses annotates the answers printed in verbose mode with
+	// their word count, language and sentiment
+	AnalyzeResponses bool
+	// Framing is the wording of the printer's labels (see council.Framings);
+	// empty means council.FramingCouncil
+	Framing string
 }
 
 // NewPrinter creates a new output printer
 func NewPrinter(verbose bool) *Printer {
+	return NewPrinterWithOptions(Options{Verbose: verbose})
+}
+
+// NewPrinterWithOptions creates a new output printer with the given options
+func NewPrinterWithOptions(opts Options) *Printer {
 	// Check if stdout is a terminal
 	isTerminal := term.IsTerminal(int(os.Stdout.Fd()))
 
 	// Disable spinner if not a TTY or if running in certain environments
-	noSpinner := !isTerminal || os.Getenv("TERM") == "dumb" || os.Getenv("CI") == "true"
+	noSpinner := !isTerminal || os.Getenv("TERM") == "dumb" || os.Getenv("CI") == "true" || opts.CIFormat == CIFormatGitHub
+
+	// In quiet mode stdout holds only the answer; progress goes to stderr
+	var out io.Writer = os.Stdout
+	if opts.Quiet {
+		out = os.Stderr
+		noSpinner = true
+	}
 
 	return &Printer{
-		verbose:    verbose,
+		verbose:    opts.Verbose && !opts.Quiet,
+		quiet:      opts.Quiet,
+		newline:    !opts.NoTrailingNewline,
+		lang:       opts.Lang,
+		noBanner:   opts.NoBanner || opts.Quiet,
+		maxLines:   opts.MaxOutputLines,
+		out:        out,
+		answerOut:  os.Stdout,
 		spinners:   make(map[string]*spinner.Spinner),
 		isTerminal: isTerminal,
 		noSpinner:  noSpinner,
+		ci:         opts.CIFormat,
+		timeout:    opts.Timeout,
+		beatEvery:  heartbeatInterval,
+		analyze:    opts.AnalyzeResponses,
+		neutral:    opts.Framing == council.FramingNeutral,
 	}
 }
 
 // PrintBanner prints the application banner
 func (p *Printer) PrintBanner() {
-	titleColor.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║          🏛️  Council - AI Model Council                ║")
-	titleColor.Println("╚════════════════════════════════════════════════════════╝")
-	fmt.Println()
+	if p.noBanner {
+		return
+	}
+	titleColor.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║          🏛️  Council - AI Model Council                ║", "Council - AI Model Council"))
+	titleColor.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+}
+
+// PrintBlankLine prints an empty separator line
+func (p *Printer) PrintBlankLine() {
+	fmt.Fprintln(p.out)
 }
 
 // PrintQuestion prints the question being asked
 func (p *Printer) PrintQuestion(question string) {
-	titleColor.Print("❓ Question: ")
-	fmt.Println(question)
+	if p.noBanner {
+		return
+	}
+	titleColor.Fprint(p.out, "❓ Question: ")
+	fmt.Fprintln(p.out, question)
+}
+
+// ClearScreen clears the terminal between watch runs; it does nothing when
+// output is not a terminal
+func (p *Printer) ClearScreen() {
+	if !p.isTerminal || p.quiet {
+		return
+	}
+	fmt.Fprint(p.out, "\033[H\033[2J")
+}
+
+// PrintWatching tells the user which prompt file is being watched
+func (p *Printer) PrintWatching(path string) {
+	fmt.Fprintln(p.out)
+	dimColor.Fprintln(p.out, fmt.Sprintf(p.tr("Watching %s for changes (Ctrl-C to exit)..."), path))
 }
 
 // PrintQueryingStart prints when querying starts
 func (p *Printer) PrintQueryingStart() {
-	fmt.Println()
-	fmt.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║ 🔄 Querying models in parallel...                      ║")
-	fmt.Println("╚════════════════════════════════════════════════════════╝")
-	fmt.Println()
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🔄 Querying models in parallel...                      ║", "Querying models in parallel..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	if p.skipHint {
+		dimColor.Fprintln(p.out, p.tr("Press s to stop waiting for the models still answering"))
+	}
+	fmt.Fprintln(p.out)
+}
+
+// SetSkipHint sets whether the query phase mentions that pressing 's'
+// skips the models still answering
+func (p *Printer) SetSkipHint(enabled bool) {
+	p.skipHint = enabled
+}
+
+// PrintRoundtableStart prints the start of the roundtable revision phase
+func (p *Printer) PrintRoundtableStart(memberCount int) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🤝 Revising answers together...                        ║", "Revising answers together..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+}
+
+// PrintCritiqueStart prints the start of the critique phase of proposer mode
+func (p *Printer) PrintCritiqueStart(criticCount int) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🧐 Critiquing the proposal...                          ║", "Critiquing the proposal..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+}
+
+// PrintMapStart prints the start of the map-reduce group summaries
+func (p *Printer) PrintMapStart(groupCount int) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🗂️  Summarizing in groups...                            ║", "Summarizing in groups..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+}
+
+// PrintRefineRoundStart prints when a refinement round starts
+func (p *Printer) PrintRefineRoundStart(round int) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintf(p.out, p.localize("║ 🔁 Refinement round %-34d ║\n", "Refinement round"), round)
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+}
+
+// PrintRoundDrafts shows how the answer evolved across refinement rounds (verbose mode)
+func (p *Printer) PrintRoundDrafts(rounds []council.Result) {
+	if !p.verbose {
+		return
+	}
+	for i, round := range rounds {
+		if round.Error != nil {
+			continue
+		}
+		title := "Initial draft"
+		if i > 0 {
+			title = fmt.Sprintf("After refinement round %d", i)
+		}
+		fmt.Fprintln(p.out)
+		fmt.Fprintln(p.out, "┌────────────────────────────────────────────────────────┐")
+		modelColor.Fprintf(p.out, "│ 📝 %-51s │\n", title)
+		fmt.Fprintln(p.out, "└────────────────────────────────────────────────────────┘")
+		fmt.Fprintln(p.out, round.AggregatedResponse)
+	}
 }
 
 // PrintReviewStart prints when peer review starts
 func (p *Printer) PrintReviewStart(modelCount int) {
-	fmt.Println()
-	fmt.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║ 📝 Conducting peer review...                           ║")
-	fmt.Println("╚════════════════════════════════════════════════════════╝")
-	fmt.Println()
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 📝 Conducting peer review...                           ║", "Conducting peer review..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
 }
 
 // StartModelSpinner starts a spinner for a model
 func (p *Printer) StartModelSpinner(model string) {
 	if p.noSpinner {
 		// No spinner, just print a simple message
-		fmt.Printf("  [⋯] %s\n", model)
+		fmt.Fprintf(p.out, "  [⋯] %s\n", model)
 		return
 	}
 
@@ -91,7 +248,10 @@ func (p *Printer) StartModelSpinner(model string) {
 	s.Suffix = fmt.Sprintf("  %s", model)
 	s.Writer = os.Stderr // Write to stderr to avoid output conflicts
 	s.Start()
+
+	p.mu.Lock()
 	p.spinners[model] = s
+	p.mu.Unlock()
 }
 
 // StopModelSpinner stops a spinner and shows result
@@ -99,66 +259,236 @@ func (p *Printer) StopModelSpinner(model string, duration time.Duration, err err
 	if p.noSpinner {
 		// Update the line we printed earlier
 		if err != nil {
-			errorColor.Printf("  [✗] %-25s ⏱️  %.2fs  ❌ %v\n", model, duration.Seconds(), err)
+			errorColor.Fprintf(p.out, "  [✗] %-25s ⏱️  %.2fs  ❌ %v\n", model, duration.Seconds(), err)
 		} else {
-			successColor.Printf("  [✓] %-25s ⏱️  %.2fs\n", model, duration.Seconds())
+			successColor.Fprintf(p.out, "  [✓] %-25s ⏱️  %.2fs\n", model, duration.Seconds())
 		}
 		return
 	}
 
-	if s, ok := p.spinners[model]; ok {
+	p.mu.Lock()
+	s, ok := p.spinners[model]
+	delete(p.spinners, model)
+	p.mu.Unlock()
+	if ok {
 		s.Stop()
-		delete(p.spinners, model)
 	}
 
 	if err != nil {
-		errorColor.Printf("  [✗] %-25s ⏱️  %.2fs  ❌ %v\n", model, duration.Seconds(), err)
+		errorColor.Fprintf(p.out, "  [✗] %-25s ⏱️  %.2fs  ❌ %v\n", model, duration.Seconds(), err)
 	} else {
-		successColor.Printf("  [✓] %-25s ⏱️  %.2fs\n", model, duration.Seconds())
+		successColor.Fprintf(p.out, "  [✓] %-25s ⏱️  %.2fs\n", model, duration.Seconds())
+	}
+}
+
+// pauseSpinners stops the running spinners while print writes, so that
+// their redraws do not cut into its output, then starts them again. It also
+// keeps boxes printed from different goroutines apart.
+func (p *Printer) pauseSpinners(print func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, s := range p.spinners {
+		s.Stop()
+	}
+	print()
+	for _, s := range p.spinners {
+		s.Start()
+	}
+}
+
+// HandleEvent renders council progress: phase banners and one spinner per member.
+// In verbose mode each successful answer is printed as soon as it arrives.
+// With CIFormatGitHub, phases also become GitHub Actions log groups.
+func (p *Printer) HandleEvent(event council.Event) {
+	p.handleCIEvent(event)
+	p.trackWaiting(event)
+	switch event.Type {
+	case council.EventPhaseStart:
+		switch event.Phase {
+		case council.PhaseQuery:
+			if p.verbose && event.Prompt != "" {
+				p.PrintPrompt(p.frame("All Council Models"), event.Prompt)
+			}
+			p.PrintQueryingStart()
+			p.printWaitEstimate(event.Count)
+		case council.PhaseReview:
+			p.PrintReviewStart(event.Count)
+		case council.PhaseRoundtable:
+			p.PrintRoundtableStart(event.Count)
+		case council.PhaseCritique:
+			p.PrintCritiqueStart(event.Count)
+		case council.PhaseMap:
+			p.PrintMapStart(event.Count)
+		case council.PhaseWarmup:
+			dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("Warming up %d sessions..."), event.Count))
+		case council.PhaseRefine:
+			p.PrintRefineRoundStart(event.Count)
+		case council.PhaseQuestion:
+			p.PrintSubQuestionStart(event.Count, event.Prompt)
+		case council.PhaseMeta:
+			p.PrintMetaStart()
+		case council.PhaseTiebreak:
+			dimColor.Fprintf(p.out, "  %s\n", p.tr("Top two responses tied, asking the tiebreaker..."))
+		}
+	case council.EventStarted:
+		// The aggregation phases have their own banner and spinner
+		if label, ok := progressLabel(event); ok {
+			p.StartModelSpinner(label)
+		}
+	case council.EventFinished:
+		label, ok := progressLabel(event)
+		if !ok {
+			return
+		}
+		p.StopModelSpinner(label, event.Duration, event.Err)
+		if p.verbose && event.Err == nil && event.Content != "" {
+			resp := copilot.Response{
+				Member:           event.Member,
+				Model:            event.Model,
+				Content:          event.Content,
+				Duration:         event.Duration,
+				TimeToFirstToken: event.TTFT,
+			}
+			if p.analyze {
+				resp = council.AnalyzeResponse(resp)
+			}
+			p.pauseSpinners(func() { p.PrintModelResponse(resp) })
+		}
+	case council.EventThrottled:
+		p.PrintVerbose("Rate limited: throttling to %d request(s) at a time, backing off %s", event.Count, event.Duration)
+	}
+}
+
+// progressLabel names the spinner line of a member's request, telling apart
+// the requests one member makes in different phases. Requests without a
+// spinner line report false.
+func progressLabel(event council.Event) (string, bool) {
+	switch {
+	case event.Phase == council.PhaseAggregation || event.Phase == council.PhaseMeta:
+		return "", false
+	case event.Attempt > 0:
+		return event.Member + " (retry)", true
+	case event.Phase == council.PhaseReview:
+		return event.Member + " (review)", true
+	case event.Phase == council.PhaseRoundtable:
+		return event.Member + " (revised)", true
+	case event.Phase == council.PhaseCritique:
+		return event.Member + " (critique)", true
+	case event.Phase == council.PhaseMap:
+		return fmt.Sprintf("%s (group %d)", event.Member, event.Count), true
+	case event.Phase == council.PhaseVerify:
+		return event.Member + " (verify)", true
+	case event.Phase == council.PhaseTiebreak:
+		return event.Member + " (tiebreak)", true
+	default:
+		return event.Member, true
 	}
 }
 
 // PrintModelResponse prints a model's response
 func (p *Printer) PrintModelResponse(resp copilot.Response) {
-	fmt.Println()
-	fmt.Println("┌────────────────────────────────────────────────────────┐")
-	modelColor.Printf("│ 🤖 %-40s ⏱️  %.2fs │\n", resp.Model, resp.Duration.Seconds())
-	fmt.Println("└────────────────────────────────────────────────────────┘")
-	fmt.Println()
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "┌────────────────────────────────────────────────────────┐")
+	label := resp.Label()
+	if label != resp.Model {
+		label = fmt.Sprintf("%s (%s)", label, resp.Model)
+	}
+	if resp.Partial {
+		label += " " + p.tr("(incomplete)")
+	}
+	memberColor(resp.Label()).Fprintf(p.out, "│ 🤖 %s ⏱️  %.2fs │\n", padRight(label, 40), resp.Duration.Seconds())
+	fmt.Fprintln(p.out, "└────────────────────────────────────────────────────────┘")
+	if resp.TimeToFirstToken > 0 {
+		dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("First token after %.2fs"), resp.TimeToFirstToken.Seconds()))
+	}
+	if resp.WordCount > 0 {
+		dimColor.Fprintf(p.out, "  %s\n", p.responseAnalysis(resp))
+	}
+	fmt.Fprintln(p.out)
 
+	// A partial answer shows what arrived before the error
+	if resp.Content != "" && (resp.Error == nil || resp.Partial) {
+		p.printLimited(resp.Content)
+	}
 	if resp.Error != nil {
-		p.PrintDetailedError(resp.Model, resp.Error, resp.Duration)
-	} else {
-		fmt.Println(resp.Content)
+		if resp.Content != "" {
+			fmt.Fprintln(p.out)
+		}
+		p.PrintDetailedError(resp.Label(), resp.Error, resp.Duration)
+	}
+	fmt.Fprintln(p.out)
+}
+
+// responseAnalysis describes an analyzed answer: its word count, then its
+// language and sentiment when they are known
+func (p *Printer) responseAnalysis(resp copilot.Response) string {
+	parts := []string{fmt.Sprintf(p.tr("%d words"), resp.WordCount)}
+	if resp.DetectedLanguage != "" {
+		parts = append(parts, resp.DetectedLanguage)
+	}
+	if resp.Sentiment != "" {
+		parts = append(parts, p.tr(resp.Sentiment))
 	}
-	fmt.Println()
+	return strings.Join(parts, " · ")
 }
 
 // PrintDetailedError prints a detailed error box
 func (p *Printer) PrintDetailedError(model string, err error, duration time.Duration) {
-	fmt.Println("╔═══════════════════════════════════════════════════════╗")
-	errorColor.Println("║ ⚠️  ERROR                                             ║")
-	fmt.Println("╠═══════════════════════════════════════════════════════╣")
-	fmt.Printf("║ Model:      %-41s ║\n", model)
-	fmt.Printf("║ Issue:      %-41s ║\n", truncate(err.Error(), 41))
-	fmt.Printf("║ Duration:   %-41s ║\n", fmt.Sprintf("%.2fs", duration.Seconds()))
+	fmt.Fprintln(p.out, "╔═══════════════════════════════════════════════════════╗")
+	errorColor.Fprintln(p.out, p.localize("║ ⚠️  ERROR                                             ║", "ERROR"))
+	fmt.Fprintln(p.out, "╠═══════════════════════════════════════════════════════╣")
+	fmt.Fprintf(p.out, p.localize("║ Model:      %-41s ║\n", "Model:"), model)
+	fmt.Fprintf(p.out, p.localize("║ Issue:      %-41s ║\n", "Issue:"), truncate(err.Error(), 41))
+	fmt.Fprintf(p.out, p.localize("║ Duration:   %-41s ║\n", "Duration:"), fmt.Sprintf("%.2fs", duration.Seconds()))
+	if detail := copilot.ErrorDetailOf(err); detail != nil {
+		if status := formatStatus(detail); status != "" {
+			fmt.Fprintf(p.out, p.localize("║ Status:     %-41s ║\n", "Status:"), truncate(status, 41))
+		}
+		if detail.RequestID != "" {
+			fmt.Fprintf(p.out, p.localize("║ Request ID: %-41s ║\n", "Request ID:"), truncate(detail.RequestID, 41))
+		}
+	}
 
 	// Suggest solution based on error
 	suggestion := getSuggestion(err)
 	if suggestion != "" {
-		fmt.Printf("║ Suggestion: %-41s ║\n", truncate(suggestion, 41))
+		fmt.Fprintf(p.out, p.localize("║ Suggestion: %s ║\n", "Suggestion:"), padRight(p.tr(suggestion), 41))
+	}
+	fmt.Fprintln(p.out, "╚═══════════════════════════════════════════════════════╝")
+}
+
+// formatStatus describes the status code and error code of a failure, e.g. "429 (rate_limited)"
+func formatStatus(detail *copilot.ErrorDetail) string {
+	switch {
+	case detail.StatusCode != 0 && detail.Code != "":
+		return fmt.Sprintf("%d (%s)", detail.StatusCode, detail.Code)
+	case detail.StatusCode != 0:
+		return fmt.Sprintf("%d", detail.StatusCode)
+	default:
+		return detail.Code
 	}
-	fmt.Println("╚═══════════════════════════════════════════════════════╝")
+}
+
+// suggestions maps typed errors to a hint shown in the error box.
+// Entries are checked in order, so list specific errors before general ones.
+var suggestions = []struct {
+	err        error
+	suggestion string
+}{
+	{copilot.ErrTimeout, "Try --timeout 120"},
+	{copilot.ErrRateLimited, "Wait and retry, or use fewer models"},
+	{copilot.ErrUnknownModel, "Run 'copilot --help' for names"},
+	{copilot.ErrEmptyResponse, "Try --retry-empty"},
+	{copilot.ErrAuth, "Run 'gh auth login'; check subscription"},
+	{copilot.ErrSessionCreate, "Check Copilot CLI is installed"},
 }
 
 // getSuggestion returns a helpful suggestion based on the error
 func getSuggestion(err error) string {
-	errStr := err.Error()
-	if strings.Contains(errStr, "timeout") {
-		return "Try --timeout 120"
-	}
-	if strings.Contains(errStr, "failed to create session") {
-		return "Check Copilot CLI is installed"
+	for _, s := range suggestions {
+		if errors.Is(err, s.err) {
+			return s.suggestion
+		}
 	}
 	return ""
 }
@@ -171,36 +501,127 @@ func truncate(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// printLimited prints a member's response, cut to the printer's line limit
+// with a note on how much was left out. Only the display is cut; the council
+// still works with the full response, and verbose mode prints it all.
+func (p *Printer) printLimited(content string) {
+	if p.maxLines <= 0 || p.verbose {
+		fmt.Fprintln(p.out, content)
+		return
+	}
+	kept, cut := truncateLines(content, p.maxLines, p.width())
+	fmt.Fprintln(p.out, kept)
+	if cut > 0 {
+		dimColor.Fprintf(p.out, "%s\n", fmt.Sprintf(p.tr("… (%d more lines; --verbose shows them, --also-markdown or --responses-dir saves them)"), cut))
+	}
+}
+
+// width returns the terminal width, or defaultWidth when output is not a terminal
+func (p *Printer) width() int {
+	if p.isTerminal {
+		return terminalWidth()
+	}
+	return defaultWidth
+}
+
+// truncateLines keeps the first maxLines display lines of content, where a
+// line wider than width wraps onto several display lines. A single huge line
+// is cut mid-line. It returns the kept text and how many display lines were cut.
+func truncateLines(content string, maxLines, width int) (string, int) {
+	width = max(width, 1)
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	var kept []string
+	used, cut := 0, 0
+	for _, line := range lines {
+		rows := wrappedRows(line, width)
+		switch {
+		case used >= maxLines:
+			cut += rows
+		case used+rows <= maxLines:
+			kept = append(kept, line)
+			used += rows
+		default:
+			// Keep the part of the line that fits the remaining rows
+			kept = append(kept, cutToWidth(line, (maxLines-used)*width))
+			cut += rows - (maxLines - used)
+			used = maxLines
+		}
+	}
+	return strings.Join(kept, "\n"), cut
+}
+
+// wrappedRows returns how many display lines a line takes at the given width
+func wrappedRows(line string, width int) int {
+	if w := displayWidth(line); w > width {
+		return (w + width - 1) / width
+	}
+	return 1
+}
+
+// cutToWidth returns the longest prefix of s that fits in width columns
+func cutToWidth(s string, width int) string {
+	used := 0
+	for i, r := range s {
+		used += displayWidth(string(r))
+		if used > width {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// padToWidth fits s into exactly width display columns: cut with "..." when
+// it is wider, padded with spaces when it is narrower
+func padToWidth(s string, width int) string {
+	if displayWidth(s) > width {
+		s = cutToWidth(s, width-3) + "..."
+	}
+	return s + strings.Repeat(" ", width-displayWidth(s))
+}
+
 // PrintAggregationStart prints when aggregation begins
 func (p *Printer) PrintAggregationStart(aggregator string, modelCount int) {
-	fmt.Println()
-	fmt.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║ 🔄 Synthesizing responses...                           ║")
-	fmt.Println("╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🔄 Synthesizing responses...                           ║", "Synthesizing responses..."))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
 
 	if p.verbose {
-		dimColor.Printf("  Aggregator: %s\n", aggregator)
-		dimColor.Printf("  Analyzing: %d responses\n", modelCount)
+		dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("Aggregator: %s"), aggregator))
+		dimColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("Analyzing: %d responses"), modelCount))
 	}
 
 	if p.noSpinner {
-		fmt.Println("  [⋯] Processing...")
+		fmt.Fprintln(p.out, "  [⋯] "+p.tr("Processing..."))
 		return
 	}
 
 	// Start aggregation spinner
 	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
-	s.Suffix = "  Processing..."
+	s.Suffix = "  " + p.tr("Processing...")
 	s.Writer = os.Stderr
 	s.Start()
 	p.spinners["aggregator"] = s
 }
 
+// SkipCached is the reason given to PrintAggregationSkipped when the
+// synthesis came from the cache
+const SkipCached = "cached synthesis reused — aggregator not called"
+
+// PrintAggregationSkipped notes that the final answer was taken from a
+// member directly; reason is a council.Skip* constant or SkipCached
+func (p *Printer) PrintAggregationSkipped(reason, member string) {
+	fmt.Fprintln(p.out)
+	successColor.Fprintf(p.out, "  [✓] %s\n", p.tr(reason))
+	dimColor.Fprintf(p.out, "      %s\n", fmt.Sprintf(p.tr("Answer from %s"), member))
+	fmt.Fprintln(p.out)
+}
+
 // StopAggregationSpinner stops the aggregation spinner
 func (p *Printer) StopAggregationSpinner(duration time.Duration) {
 	if p.noSpinner {
-		successColor.Printf("  [✓] Synthesis complete (%.2fs)\n", duration.Seconds())
-		fmt.Println()
+		successColor.Fprintf(p.out, "  [✓] %s\n", fmt.Sprintf(p.tr("Synthesis complete (%.2fs)"), duration.Seconds()))
+		fmt.Fprintln(p.out)
 		return
 	}
 
@@ -208,102 +629,387 @@ func (p *Printer) StopAggregationSpinner(duration time.Duration) {
 		s.Stop()
 		delete(p.spinners, "aggregator")
 	}
-	successColor.Printf("  [✓] Synthesis complete (%.2fs)\n", duration.Seconds())
-	fmt.Println()
+	successColor.Fprintf(p.out, "  [✓] %s\n", fmt.Sprintf(p.tr("Synthesis complete (%.2fs)"), duration.Seconds()))
+	fmt.Fprintln(p.out)
 }
 
 // PrintFinalResult prints the final aggregated result
 func (p *Printer) PrintFinalResult(content string) {
-	fmt.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║ ⭐ FINAL ANSWER                                        ║")
-	fmt.Println("╚════════════════════════════════════════════════════════╝")
-	fmt.Println()
-	fmt.Println(content)
-	fmt.Println()
+	if p.quiet {
+		fmt.Fprint(p.answerOut, strings.TrimRight(content, "\r\n"))
+		if p.newline {
+			fmt.Fprintln(p.answerOut)
+		}
+		return
+	}
+
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ ⭐ FINAL ANSWER                                        ║", "FINAL ANSWER"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, content)
+	fmt.Fprintln(p.out)
+}
+
+// PrintDissents prints the members' disagreements with the final answer
+func (p *Printer) PrintDissents(dissents []council.Dissent) {
+	if len(dissents) == 0 {
+		return
+	}
+
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🗣️  DISSENTING OPINIONS                                 ║", "DISSENTING OPINIONS"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+	for _, dissent := range dissents {
+		memberColor(dissent.Member).Fprintf(p.out, "  %s:\n", dissent.Member)
+		fmt.Fprintf(p.out, "    %s\n\n", strings.ReplaceAll(dissent.Point, "\n", "\n    "))
+	}
+}
+
+// PrintClaimChecks lists the claims of the final answer that no member's
+// response supports; the answer itself marks them "[unsupported]"
+func (p *Printer) PrintClaimChecks(checks []council.ClaimCheck) {
+	var unsupported []council.ClaimCheck
+	for _, check := range checks {
+		if !check.Supported {
+			unsupported = append(unsupported, check)
+		}
+	}
+	if len(checks) == 0 {
+		return
+	}
+
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🔎 CLAIM CHECK                                         ║", "CLAIM CHECK"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+	if len(unsupported) == 0 {
+		successColor.Fprintf(p.out, "  %s\n\n", fmt.Sprintf(p.tr("All %d claims are supported by a member's answer"), len(checks)))
+		return
+	}
+	warningColor.Fprintf(p.out, "  %s\n", fmt.Sprintf(p.tr("%d of %d claims are not supported by any member's answer:"), len(unsupported), len(checks)))
+	for _, check := range unsupported {
+		warningColor.Fprintf(p.out, "    ✗ %s\n", check.Claim)
+	}
+	fmt.Fprintln(p.out)
+}
+
+// PrintStrategies prints the final answer of each aggregation strategy, one
+// labeled section per strategy
+func (p *Printer) PrintStrategies(aggregations []council.Aggregation) {
+	if len(aggregations) == 0 {
+		return
+	}
+
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🧪 STRATEGY COMPARISON                                 ║", "STRATEGY COMPARISON"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+	for _, agg := range aggregations {
+		header := agg.Strategy
+		if agg.Source != "" {
+			header += fmt.Sprintf(" → %s", agg.Source)
+		}
+		if agg.Duration > 0 {
+			header += fmt.Sprintf(" (%.2fs)", agg.Duration.Seconds())
+		}
+		modelColor.Fprintf(p.out, "── %s ──\n", header)
+		if agg.Err != nil {
+			errorColor.Fprintf(p.out, "  %v\n\n", agg.Err)
+			continue
+		}
+		fmt.Fprintf(p.out, "%s\n\n", agg.Answer)
+	}
+}
+
+// PrintEchoWarning prints a prominent warning that the final answer copies a single model
+func (p *Printer) PrintEchoWarning(model string, similarity float64) {
+	warningColor.Fprintf(p.out, "⚠️  Final answer closely matches %s (%.0f%% similar) - %s may not have synthesized\n", model, similarity*100, p.frame("the chairman"))
+	fmt.Fprintln(p.out)
+}
+
+// PrintWarning prints a non-fatal warning
+func (p *Printer) PrintWarning(format string, args ...interface{}) {
+	warningColor.Fprintf(os.Stderr, "⚠️  "+format+"\n", args...)
+}
+
+// PrintAggregatorReasoning prints the chairman's reasoning (verbose mode)
+func (p *Printer) PrintAggregatorReasoning(reasoning string) {
+	if !p.verbose || reasoning == "" {
+		return
+	}
+
+	fmt.Fprintln(p.out, "┌────────────────────────────────────────────────────────┐")
+	modelColor.Fprintln(p.out, p.localize("│ 🧠 CHAIRMAN'S REASONING                                │", "CHAIRMAN'S REASONING"))
+	fmt.Fprintln(p.out, "└────────────────────────────────────────────────────────┘")
+	dimColor.Fprintln(p.out, reasoning)
+	fmt.Fprintln(p.out)
 }
 
 // PrintError prints an error message
 func (p *Printer) PrintError(err error) {
-	errorColor.Printf("\n✗ Error: %v\n", err)
+	errorColor.Fprintf(os.Stderr, "\n✗ Error: %v\n", err)
 }
 
-// PrintSummary prints a summary of the execution
-func (p *Printer) PrintSummary(result council.Result, totalDuration time.Duration) {
-	fmt.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║ 📊 EXECUTION SUMMARY                                   ║")
-	fmt.Println("╠════════════════════════════════════════════════════════╣")
+// failedMembers returns the labels of the responses that failed
+func failedMembers(responses []copilot.Response) []string {
+	var failed []string
+	for _, resp := range responses {
+		if resp.Error != nil {
+			failed = append(failed, resp.Label())
+		}
+	}
+	return failed
+}
 
-	// Stage 1: Initial Responses
-	successCount := 0
-	var fastestModel string
-	var fastestDuration time.Duration = time.Hour
-	var slowestDuration time.Duration
-	var stage1Time time.Duration
+// partialMembers lists the members whose answers broke off mid-stream
+func partialMembers(responses []copilot.Response) []string {
+	var partial []string
+	for _, resp := range responses {
+		if resp.Partial {
+			partial = append(partial, resp.Label())
+		}
+	}
+	return partial
+}
 
+// rankedReviews counts the reviews that produced rankings
+func rankedReviews(reviews []council.Review) int {
+	n := 0
+	for _, review := range reviews {
+		if review.Error == nil && len(review.Rankings) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// retrySummary lists who had to be asked again and how often, e.g.
+// "model-a (2), aggregation (1)"; it is empty when nothing was retried
+func retrySummary(result council.Result) string {
+	var parts []string
 	for _, resp := range result.ModelResponses {
-		if resp.Error == nil {
-			successCount++
-			if resp.Duration > stage1Time {
-				stage1Time = resp.Duration // Max time (parallel execution)
-			}
+		if resp.Retries > 0 {
+			parts = append(parts, fmt.Sprintf("%s (%d)", resp.Label(), resp.Retries))
+		}
+	}
+	if result.AggregationRetries > 0 {
+		parts = append(parts, fmt.Sprintf("aggregation (%d)", result.AggregationRetries))
+	}
+	return strings.Join(parts, ", ")
+}
 
-			if resp.Duration < fastestDuration {
-				fastestDuration = resp.Duration
-				fastestModel = resp.Model
-			}
-			if resp.Duration > slowestDuration {
-				slowestDuration = resp.Duration
-			}
+// refusedMembers lists the members whose answers were flagged as refusals
+func refusedMembers(responses []copilot.Response) []string {
+	var refused []string
+	for _, resp := range responses {
+		if resp.Refused {
+			refused = append(refused, resp.Label())
 		}
 	}
+	return refused
+}
+
+// PrintSummary prints a summary of the execution
+func (p *Printer) PrintSummary(result council.Result, totalDuration time.Duration) {
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 📊 EXECUTION SUMMARY                                   ║", "EXECUTION SUMMARY"))
+	fmt.Fprintln(p.out, "╠════════════════════════════════════════════════════════╣")
 
-	fmt.Println("║                                                        ║")
-	titleColor.Println("║ Stage 1: Initial Responses                             ║")
-	if successCount == len(result.ModelResponses) {
-		successColor.Printf("║   Models queried:    %-33s ║\n", fmt.Sprintf("%d/%d successful", successCount, len(result.ModelResponses)))
+	stats := council.Stats(result)
+	successCount := stats.Query.Succeeded
+
+	// Stage 1: Initial Responses
+	fmt.Fprintln(p.out, "║                                                        ║")
+	titleColor.Fprintln(p.out, p.localize("║ Stage 1: Initial Responses                             ║", "Stage 1: Initial Responses"))
+	if stats.Query.Failed == 0 {
+		successColor.Fprintf(p.out, p.localize("║   Models queried:    %-33s ║\n", "Models queried:"), fmt.Sprintf("%d/%d successful", successCount, stats.Query.Total))
 	} else {
-		warningColor.Printf("║   Models queried:    %-33s ║\n", fmt.Sprintf("%d/%d successful", successCount, len(result.ModelResponses)))
+		warningColor.Fprintf(p.out, p.localize("║   Models queried:    %-33s ║\n", "Models queried:"), fmt.Sprintf("%d/%d successful", successCount, stats.Query.Total))
+	}
+
+	if failed := failedMembers(result.ModelResponses); len(failed) > 0 {
+		warningColor.Fprintf(p.out, p.localize("║   Failed:            %-33s ║\n", "Failed:"), truncate(strings.Join(failed, ", "), 33))
+	}
+	if partial := partialMembers(result.ModelResponses); len(partial) > 0 {
+		warningColor.Fprintf(p.out, p.localize("║   Incomplete:        %-33s ║\n", "Incomplete:"), truncate(strings.Join(partial, ", "), 33))
+	}
+	if refused := refusedMembers(result.ModelResponses); len(refused) > 0 {
+		warningColor.Fprintf(p.out, p.localize("║   Refused:           %-33s ║\n", "Refused:"), truncate(strings.Join(refused, ", "), 33))
+	}
+	if result.DistinctViewpoints > 0 {
+		viewpoints := fmt.Sprintf("%d distinct", result.DistinctViewpoints)
+		if len(result.AddedMembers) > 0 {
+			viewpoints += fmt.Sprintf(" (%d models added)", len(result.AddedMembers))
+		}
+		fmt.Fprintf(p.out, p.localize("║   Viewpoints:        %-33s ║\n", "Viewpoints:"), viewpoints)
 	}
 
 	if successCount > 0 {
-		fmt.Printf("║   Fastest:           %-33s ║\n", fmt.Sprintf("%s (%.2fs)", fastestModel, fastestDuration.Seconds()))
-		fmt.Printf("║   Phase time:        %-33s ║\n", fmt.Sprintf("%.2fs", stage1Time.Seconds()))
+		fmt.Fprintf(p.out, p.localize("║   Fastest:           %s ║\n", "Fastest:"), memberValue(fmt.Sprintf("%s (%.2fs)", stats.Fastest, stats.FastestDuration.Seconds()), stats.Fastest, 33))
+		fmt.Fprintf(p.out, p.localize("║   Phase time:        %-33s ║\n", "Phase time:"), fmt.Sprintf("%.2fs", stats.QueryDuration.Seconds()))
+		if result.WarmupDuration > 0 {
+			fmt.Fprintf(p.out, p.localize("║   Warmup:            %-33s ║\n", "Warmup:"), fmt.Sprintf("%.2fs (not in phase time)", result.WarmupDuration.Seconds()))
+		}
+	}
+
+	// Stage 2: Roundtable, which replaces peer review
+	if len(result.InitialResponses) > 0 {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 2: Roundtable                                    ║", "Stage 2: Roundtable"))
+		fmt.Fprintf(p.out, p.localize("║   Revised:           %-33s ║\n", "Revised:"), fmt.Sprintf("%d/%d answers", result.Revisions, successCount))
+	}
+
+	// Stage 2: Critique, which replaces peer review in proposer mode
+	if len(result.Critiques) > 0 {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 2: Critique                                      ║", "Stage 2: Critique"))
+		fmt.Fprintf(p.out, p.localize("║   Proposal from:     %s ║\n", "Proposal from:"), memberValue(result.ModelResponses[0].Label(), result.ModelResponses[0].Label(), 33))
+		fmt.Fprintf(p.out, p.localize("║   Critiques:         %-33s ║\n", "Critiques:"), fmt.Sprintf("%d/%d successful", stats.Critique.Succeeded, stats.Critique.Total))
+		fmt.Fprintf(p.out, p.localize("║   Phase time:        %-33s ║\n", "Phase time:"), fmt.Sprintf("%.2fs", result.CritiqueDuration.Seconds()))
 	}
 
 	// Stage 2: Peer Review
+	if result.ReviewSkipped != "" {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 2: Peer Review                                   ║", "Stage 2: Peer Review"))
+		fmt.Fprintf(p.out, p.localize("║   Skipped:           %-33s ║\n", "Skipped:"), truncate(result.ReviewSkipped, 33))
+	}
 	if len(result.Reviews) > 0 {
-		reviewSuccess := 0
-		for _, review := range result.Reviews {
-			if review.Error == nil {
-				reviewSuccess++
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 2: Peer Review                                   ║", "Stage 2: Peer Review"))
+		fmt.Fprintf(p.out, p.localize("║   Reviews completed: %-33s ║\n", "Reviews completed:"), fmt.Sprintf("%d/%d successful", stats.Review.Succeeded, stats.Review.Total))
+		if result.SkippedReviews > 0 {
+			fmt.Fprintf(p.out, p.localize("║   Reviews skipped:   %-33s ║\n", "Reviews skipped:"), fmt.Sprintf("%d (quorum reached)", result.SkippedReviews))
+		}
+		if len(result.Abstained) > 0 {
+			fmt.Fprintf(p.out, p.localize("║   Abstained:         %-33s ║\n", "Abstained:"), truncate(strings.Join(result.Abstained, ", "), 33))
+		}
+		if len(result.GatedReviewers) > 0 {
+			fmt.Fprintf(p.out, p.localize("║   Not reviewing:     %-33s ║\n", "Not reviewing:"), truncate(strings.Join(result.GatedReviewers, ", ")+" (thin answer)", 33))
+		}
+		reviewers := len(result.Reviewers)
+		if reviewers == 0 {
+			reviewers = successCount
+		} else if reviewers < successCount-len(result.Abstained)-len(result.GatedReviewers) {
+			fmt.Fprintf(p.out, p.localize("║   Reviewers:         %-33s ║\n", "Reviewers:"), fmt.Sprintf("%d/%d (sampled)", reviewers, successCount))
+		}
+		if fullPairs := reviewers * (successCount - 1); result.ReviewPairs > 0 && result.ReviewPairs < fullPairs {
+			fmt.Fprintf(p.out, p.localize("║   Review pairs:      %-33s ║\n", "Review pairs:"), fmt.Sprintf("%d/%d (capped)", result.ReviewPairs, fullPairs))
+		}
+		if len(result.Consensus) > 0 {
+			top := result.Consensus[0]
+			fmt.Fprintf(p.out, p.localize("║   Top ranked:        %s ║\n", "Top ranked:"), memberValue(fmt.Sprintf("%s (%.2f)", top.Model, top.Score), top.Model, 33))
+		}
+		if tb := result.Tiebreak; tb != nil {
+			if tb.Winner != "" {
+				loser := tb.Between[0]
+				if loser == tb.Winner {
+					loser = tb.Between[1]
+				}
+				fmt.Fprintf(p.out, p.localize("║   Tiebreak:          %-33s ║\n", "Tiebreak:"), truncate(fmt.Sprintf("%s over %s (%s)", tb.Winner, loser, tb.Model), 33))
+			} else {
+				warningColor.Fprintf(p.out, p.localize("║   Tiebreak:          %-33s ║\n", "Tiebreak:"), truncate(fmt.Sprintf("failed (%s)", tb.Model), 33))
 			}
 		}
+		if result.Favorite != "" {
+			favorite := fmt.Sprintf("%s (%d/%d votes)", result.Favorite, result.FavoriteVotes, rankedReviews(result.Reviews))
+			fmt.Fprintf(p.out, p.localize("║   🏆 Council favorite: %s ║\n", "Council favorite:"), memberValue(favorite, result.Favorite, 31))
+			if result.ClosestToAnswer != "" {
+				alignment := "follows the favorite"
+				if result.ClosestToAnswer != result.Favorite {
+					alignment = "closest to " + result.ClosestToAnswer
+				}
+				fmt.Fprintf(p.out, p.localize("║   Synthesis:         %-33s ║\n", "Synthesis:"), truncate(alignment, 33))
+			}
+		}
+		if len(result.PluralityWinner) > 1 {
+			tied := fmt.Sprintf("%s (%d/%d each)", strings.Join(result.PluralityWinner, ", "), result.PluralityVotes, rankedReviews(result.Reviews))
+			fmt.Fprintf(p.out, p.localize("║   Tied for first:    %-33s ║\n", "Tied for first:"), truncate(tied, 33))
+		}
+		fmt.Fprintf(p.out, p.localize("║   Phase time:        %-33s ║\n", "Phase time:"), fmt.Sprintf("%.2fs", result.ReviewDuration.Seconds()))
+	}
 
-		fmt.Println("║                                                        ║")
-		titleColor.Println("║ Stage 2: Peer Review                                   ║")
-		fmt.Printf("║   Reviews completed: %-33s ║\n", fmt.Sprintf("%d/%d successful", reviewSuccess, len(result.Reviews)))
-		fmt.Printf("║   Phase time:        %-33s ║\n", fmt.Sprintf("%.2fs", result.ReviewDuration.Seconds()))
+	// Stage 3: Field vote, which replaces the synthesis for structured answers
+	if len(result.FieldVotes) > 0 || len(result.NonConforming) > 0 {
+		split := 0
+		for _, vote := range result.FieldVotes {
+			if vote.Votes < vote.Voters {
+				split++
+			}
+		}
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 3: Field Vote                                    ║", "Stage 3: Field Vote"))
+		fmt.Fprintf(p.out, p.localize("║   Conforming:        %-33s ║\n", "Conforming:"), fmt.Sprintf("%d/%d answers", successCount-len(result.NonConforming), successCount))
+		if len(result.NonConforming) > 0 {
+			members := make([]string, len(result.NonConforming))
+			for i, violation := range result.NonConforming {
+				members[i] = violation.Member
+			}
+			warningColor.Fprintf(p.out, p.localize("║   Non-conforming:    %-33s ║\n", "Non-conforming:"), truncate(strings.Join(members, ", "), 33))
+		}
+		if len(result.FieldVotes) > 0 {
+			fmt.Fprintf(p.out, p.localize("║   Fields:            %-33s ║\n", "Fields:"), fmt.Sprintf("%d voted, %d split", len(result.FieldVotes), split))
+		}
 	}
 
 	// Stage 3: Final Synthesis
-	if result.AggregationDuration > 0 {
-		fmt.Println("║                                                        ║")
-		titleColor.Println("║ Stage 3: Final Synthesis                               ║")
-		fmt.Printf("║   Phase time:        %-33s ║\n", fmt.Sprintf("%.2fs", result.AggregationDuration.Seconds()))
+	if result.AggregationSkipped != "" {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 3: Final Synthesis                               ║", "Stage 3: Final Synthesis"))
+		fmt.Fprintf(p.out, p.localize("║   Skipped:           %s ║\n", "Skipped:"), padToWidth(p.tr(result.AggregationSkipped), 33))
+		fmt.Fprintf(p.out, p.localize("║   Answer from:       %s ║\n", "Answer from:"), memberValue(result.AggregatorModel, result.AggregatorModel, 33))
+	} else if result.AggregationDuration > 0 || result.AggregationCached {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Stage 3: Final Synthesis                               ║", "Stage 3: Final Synthesis"))
+		chairman := result.AggregatorModel
+		if result.AggregationCached {
+			chairman += " (cached)"
+		}
+		fmt.Fprintf(p.out, p.localize("║   Chairman:          %s ║\n", "Chairman:"), memberValue(chairman, result.AggregatorModel, 33))
+		if result.AnswerLength != "" {
+			length := result.AnswerLength
+			if result.AnswerTrimmed {
+				length += " (trimmed)"
+			}
+			fmt.Fprintf(p.out, p.localize("║   Length:            %-33s ║\n", "Length:"), truncate(length, 33))
+		}
+		fmt.Fprintf(p.out, p.localize("║   Phase time:        %-33s ║\n", "Phase time:"), fmt.Sprintf("%.2fs", result.AggregationDuration.Seconds()))
+		if result.EchoedModel != "" {
+			warningColor.Fprintf(p.out, p.localize("║   Echo:              %-33s ║\n", "Echo:"), truncate(fmt.Sprintf("closely matches %s", result.EchoedModel), 33))
+		}
+	}
+
+	if len(result.Rounds) > 1 {
+		completed := 0
+		for _, round := range result.Rounds[1:] {
+			if round.Error == nil {
+				completed++
+			}
+		}
+		fmt.Fprintln(p.out, "║                                                        ║")
+		titleColor.Fprintln(p.out, p.localize("║ Refinement                                             ║", "Refinement"))
+		fmt.Fprintf(p.out, p.localize("║   Rounds:            %-33s ║\n", "Rounds:"), fmt.Sprintf("%d/%d completed", completed, len(result.Rounds)-1))
+	}
+
+	if retries := retrySummary(result); retries != "" {
+		fmt.Fprintln(p.out, "║                                                        ║")
+		warningColor.Fprintf(p.out, p.localize("║ Retries:             %-33s ║\n", "Retries:"), truncate(retries, 33))
 	}
 
 	// Total
-	fmt.Println("║                                                        ║")
-	fmt.Println("╠════════════════════════════════════════════════════════╣")
-	fmt.Printf("║ Total execution time: %-32s ║\n", fmt.Sprintf("%.2fs", totalDuration.Seconds()))
+	fmt.Fprintln(p.out, "║                                                        ║")
+	fmt.Fprintln(p.out, "╠════════════════════════════════════════════════════════╣")
+	fmt.Fprintf(p.out, p.localize("║ Total execution time: %-32s ║\n", "Total execution time:"), fmt.Sprintf("%.2fs", totalDuration.Seconds()))
 
-	fmt.Println("╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
 }
 
 // PrintVerbose prints verbose information
 func (p *Printer) PrintVerbose(format string, args ...interface{}) {
 	if p.verbose {
-		dimColor.Printf("[VERBOSE] "+format+"\n", args...)
+		dimColor.Fprintf(p.out, "[VERBOSE] "+format+"\n", args...)
 	}
 }
 
@@ -313,12 +1019,23 @@ func (p *Printer) PrintPrompt(model, prompt string) {
 		return
 	}
 
-	fmt.Println()
-	fmt.Println("┌────────────────────────────────────────────────────────┐")
-	modelColor.Printf("│ 📤 PROMPT TO: %-39s │\n", model)
-	fmt.Println("└────────────────────────────────────────────────────────┘")
-	dimColor.Println(prompt)
-	fmt.Println()
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "┌────────────────────────────────────────────────────────┐")
+	modelColor.Fprintf(p.out, "│ 📤 PROMPT TO: %-39s │\n", model)
+	fmt.Fprintln(p.out, "└────────────────────────────────────────────────────────┘")
+	dimColor.Fprintln(p.out, prompt)
+	fmt.Fprintln(p.out)
+}
+
+// PrintPromptTrace writes a prompt to stderr as it is sent (--trace-prompts).
+// Unlike PrintPrompt it is unformatted and interleaves with the live run.
+func (p *Printer) PrintPromptTrace(stage, model, prompt string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	dimColor.Fprintf(os.Stderr, "[TRACE %s] %s → %s\n", time.Now().Format("15:04:05.000"), stage, model)
+	fmt.Fprintln(os.Stderr, prompt)
+	dimColor.Fprintln(os.Stderr, "[TRACE END]")
 }
 
 // PrintResponse prints the response from a model (verbose mode)
@@ -327,17 +1044,40 @@ func (p *Printer) PrintResponse(model, response string) {
 		return
 	}
 
-	fmt.Println("┌────────────────────────────────────────────────────────┐")
-	modelColor.Printf("│ 📥 RESPONSE FROM: %-35s │\n", model)
-	fmt.Println("└────────────────────────────────────────────────────────┘")
-	fmt.Println(response)
-	fmt.Println()
+	fmt.Fprintln(p.out, "┌────────────────────────────────────────────────────────┐")
+	modelColor.Fprintf(p.out, "│ 📥 RESPONSE FROM: %-35s │\n", model)
+	fmt.Fprintln(p.out, "└────────────────────────────────────────────────────────┘")
+	fmt.Fprintln(p.out, response)
+	fmt.Fprintln(p.out)
 }
 
 // PrintReviewPhaseComplete prints when peer review phase is complete
 func (p *Printer) PrintReviewPhaseComplete(reviewCount int, duration time.Duration) {
-	fmt.Println()
-	successColor.Printf("  [✓] Peer review complete: %d models reviewed each other (%.2fs)\n", reviewCount, duration.Seconds())
+	fmt.Fprintln(p.out)
+	successColor.Fprintf(p.out, "  [✓] Peer review complete: %d models reviewed each other (%.2fs)\n", reviewCount, duration.Seconds())
+}
+
+// PrintGroupSummaries prints the chairman's group summaries of a map-reduce
+// synthesis (verbose mode)
+func (p *Printer) PrintGroupSummaries(summaries []council.GroupSummary) {
+	if !p.verbose || len(summaries) == 0 {
+		return
+	}
+
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 🗂️  GROUP SUMMARIES                                     ║", "GROUP SUMMARIES"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
+
+	for i, summary := range summaries {
+		titleColor.Fprintf(p.out, "Group %d: %s\n", i+1, strings.Join(summary.Members, ", "))
+		if summary.Err != nil {
+			errorColor.Fprintf(p.out, "  Error: %v (responses passed on verbatim)\n\n", summary.Err)
+			continue
+		}
+		fmt.Fprintf(p.out, "%s\n\n", strings.TrimSpace(summary.Summary))
+	}
 }
 
 // PrintPeerReviews prints detailed peer review information (verbose mode)
@@ -346,23 +1086,25 @@ func (p *Printer) PrintPeerReviews(reviews []council.Review) {
 		return
 	}
 
-	fmt.Println()
-	fmt.Println("╔════════════════════════════════════════════════════════╗")
-	titleColor.Println("║ 📝 PEER REVIEW RESULTS                                 ║")
-	fmt.Println("╚════════════════════════════════════════════════════════╝")
-	fmt.Println()
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "╔════════════════════════════════════════════════════════╗")
+	titleColor.Fprintln(p.out, p.localize("║ 📝 PEER REVIEW RESULTS                                 ║", "PEER REVIEW RESULTS"))
+	fmt.Fprintln(p.out, "╚════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(p.out)
 
 	for _, review := range reviews {
-		modelColor.Printf("🔍 %s's Evaluation:\n", review.ReviewerModel)
-		if review.Error != nil {
-			errorColor.Printf("  Error: %v\n", review.Error)
+		memberColor(review.ReviewerModel).Fprintf(p.out, "🔍 %s's Evaluation:\n", review.ReviewerModel)
+		if review.Skipped {
+			dimColor.Fprintln(p.out, "  (Skipped - review quorum reached)")
+		} else if review.Error != nil {
+			errorColor.Fprintf(p.out, "  Error: %v\n", review.Error)
 		} else if len(review.Rankings) > 0 {
 			for _, ranking := range review.Rankings {
-				fmt.Printf("  Rank %d: %s\n", ranking.Rank, ranking.Reasoning)
+				fmt.Fprintf(p.out, "  Rank %d: %s\n", ranking.Rank, ranking.Reasoning)
 			}
 		} else {
-			dimColor.Println("  (No structured rankings extracted)")
+			dimColor.Fprintln(p.out, "  (No structured rankings extracted)")
 		}
-		fmt.Println()
+		fmt.Fprintln(p.out)
 	}
 }
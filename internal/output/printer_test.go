@@ -0,0 +1,601 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestGetSuggestion(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"timeout", copilot.ErrTimeout, "Try --timeout 120"},
+		{"rate limited", fmt.Errorf("failed to send message: %w", copilot.ErrRateLimited), "Wait and retry, or use fewer models"},
+		{"unknown model", fmt.Errorf("%w for model gpt-9: %w", copilot.ErrSessionCreate, copilot.ErrUnknownModel), "Run 'copilot --help' for names"},
+		{"empty response", copilot.ErrEmptyResponse, "Try --retry-empty"},
+		{"auth", fmt.Errorf("failed to send message: %w", copilot.ErrAuth), "Run 'gh auth login'; check subscription"},
+		{"session create", fmt.Errorf("%w for model gpt-5.2: boom", copilot.ErrSessionCreate), "Check Copilot CLI is installed"},
+		{"unclassified", errors.New("connection reset"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSuggestion(tt.err); got != tt.want {
+				t.Errorf("getSuggestion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggestionsFitErrorBox(t *testing.T) {
+	for _, s := range suggestions {
+		if len(s.suggestion) > 41 {
+			t.Errorf("suggestion %q is longer than the 41-column error box", s.suggestion)
+		}
+		for lang, messages := range catalog {
+			if translated, ok := messages[s.suggestion]; ok && displayWidth(translated) > 41 {
+				t.Errorf("%s suggestion %q is wider than the 41-column error box", lang, translated)
+			}
+		}
+	}
+}
+
+func TestHandleEventPrintsResponsesAsTheyArrive(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{verbose: true, out: &out, noSpinner: true, spinners: make(map[string]*spinner.Spinner)}
+
+	p.HandleEvent(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "model-a", Model: "model-a"})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-a", Model: "model-a", Content: "First answer"})
+	if !strings.Contains(out.String(), "First answer") {
+		t.Errorf("expected the answer to be printed on completion, got %q", out.String())
+	}
+
+	out.Reset()
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-b", Model: "model-b", Err: errors.New("timeout")})
+	if strings.Contains(out.String(), "┌") {
+		t.Errorf("failed responses should not get a response box, got %q", out.String())
+	}
+
+	out.Reset()
+	p.verbose = false
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-c", Model: "model-c", Content: "Hidden"})
+	if strings.Contains(out.String(), "Hidden") {
+		t.Errorf("answers are only shown in verbose mode, got %q", out.String())
+	}
+}
+
+func TestLangSwitchesLabels(t *testing.T) {
+	result := council.Result{
+		ModelResponses:     []copilot.Response{{Model: "model-a", Content: "answer"}},
+		AggregatedResponse: "answer",
+	}
+
+	var en, ja bytes.Buffer
+	(&Printer{out: &en}).PrintSummary(result, time.Second)
+	(&Printer{out: &ja, lang: LangJapanese}).PrintSummary(result, time.Second)
+
+	if !strings.Contains(en.String(), "EXECUTION SUMMARY") {
+		t.Errorf("expected the English title, got %q", en.String())
+	}
+	if strings.Contains(ja.String(), "EXECUTION SUMMARY") || !strings.Contains(ja.String(), "実行サマリー") {
+		t.Errorf("expected the Japanese title, got %q", ja.String())
+	}
+
+	// Translated lines keep the box borders aligned
+	boxWidth := displayWidth("╔" + strings.Repeat("═", 56) + "╗")
+	for _, line := range strings.Split(ja.String(), "\n") {
+		if strings.Contains(line, "ステージ") || strings.Contains(line, "問い合わせ:") {
+			if w := displayWidth(line); w != boxWidth {
+				t.Errorf("line %q is %d columns wide, want %d", line, w, boxWidth)
+			}
+		}
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "ja_JP.UTF-8")
+	if got, _ := DetectLanguage(""); got != LangJapanese {
+		t.Errorf("DetectLanguage() with LANG=ja_JP.UTF-8 = %q, want %q", got, LangJapanese)
+	}
+	if got, _ := DetectLanguage("EN"); got != LangEnglish {
+		t.Errorf("DetectLanguage(\"EN\") = %q, want %q", got, LangEnglish)
+	}
+
+	t.Setenv("LANG", "C")
+	if got, _ := DetectLanguage(""); got != LangEnglish {
+		t.Errorf("DetectLanguage() with LANG=C = %q, want %q", got, LangEnglish)
+	}
+	if _, err := DetectLanguage("fr"); err == nil {
+		t.Error("expected an error for an unsupported language")
+	}
+}
+
+func TestLangTranslatesSynthesis(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out, lang: LangJapanese, verbose: true, noSpinner: true}
+	p.PrintAggregationStart("gpt-4.1", 3)
+	p.StopAggregationSpinner(1500 * time.Millisecond)
+	p.PrintAggregationSkipped(council.SkipUnanimous, "model-a")
+	p.PrintAggregationSkipped(SkipCached, "model-a")
+
+	for _, english := range []string{"Aggregator:", "Analyzing:", "Processing...", "Synthesis complete", "Answer from", "unanimous council", "cached synthesis"} {
+		if strings.Contains(out.String(), english) {
+			t.Errorf("expected %q to be translated, got:\n%s", english, out.String())
+		}
+	}
+	for _, want := range []string{"集約モデル: gpt-4.1", "分析対象: 3 件の回答", "処理中...", "統合完了 (1.50s)", "回答元: model-a", "全会一致のため統合を省略"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+
+	// The translated reason keeps the summary box aligned
+	out.Reset()
+	p.PrintSummary(council.Result{
+		ModelResponses:     []copilot.Response{{Model: "model-a", Content: "A"}, {Model: "model-b", Content: "A"}},
+		AggregatedResponse: "A",
+		AggregatorModel:    "model-a",
+		AggregationSkipped: council.SkipSingleSuccess,
+	}, time.Second)
+	boxWidth := displayWidth("╔" + strings.Repeat("═", 56) + "╗")
+	found := false
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.Contains(line, "省略:") {
+			found = true
+			if !strings.Contains(line, "単一モデルの回答を採用") || displayWidth(line) != boxWidth {
+				t.Errorf("line %q should hold the translated reason and be %d columns wide", line, boxWidth)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("expected a skipped line in:\n%s", out.String())
+	}
+}
+
+func TestNeutralFramingSwitchesLabels(t *testing.T) {
+	result := council.Result{
+		ModelResponses:      []copilot.Response{{Model: "model-a", Content: "A"}, {Model: "model-b", Content: "B"}},
+		Reviews:             []council.Review{{ReviewerModel: "model-b", Rankings: []council.Ranking{{Model: "model-a", Rank: 1}}}},
+		Favorite:            "model-a",
+		FavoriteVotes:       1,
+		AggregatedResponse:  "answer",
+		AggregatorModel:     "judge",
+		AggregationDuration: time.Second,
+	}
+
+	for _, lang := range Languages {
+		var framed, neutral bytes.Buffer
+		for _, p := range []*Printer{{out: &framed, lang: lang}, {out: &neutral, lang: lang, neutral: true}} {
+			p.PrintBanner()
+			p.PrintSummary(result, time.Second)
+		}
+
+		for _, term := range []string{"Council", "Chairman", "評議会", "議長"} {
+			if strings.Contains(neutral.String(), term) {
+				t.Errorf("%s: expected no %q with the neutral framing, got:\n%s", lang, term, neutral.String())
+			}
+		}
+		// Plain terms keep the box borders where the council's are
+		framedLines, neutralLines := strings.Split(framed.String(), "\n"), strings.Split(neutral.String(), "\n")
+		if len(framedLines) != len(neutralLines) {
+			t.Fatalf("%s: the framings print different lines:\n%s\n%s", lang, framed.String(), neutral.String())
+		}
+		for i, line := range neutralLines {
+			if displayWidth(line) != displayWidth(framedLines[i]) {
+				t.Errorf("%s: line %q is not as wide as %q", lang, line, framedLines[i])
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	p := &Printer{out: &out, neutral: NewPrinterWithOptions(Options{Framing: council.FramingNeutral, Quiet: true}).neutral}
+	p.PrintSummary(result, time.Second)
+	for _, want := range []string{"Top pick:         model-a", "Synthesizer:       judge"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestQuietSendsProgressToStderr(t *testing.T) {
+	capture := func(target **os.File) (read func() string) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved := *target
+		*target = w
+		var buf bytes.Buffer
+		copied := make(chan struct{})
+		go func() {
+			_, _ = io.Copy(&buf, r)
+			close(copied)
+		}()
+		return func() string {
+			*target = saved
+			w.Close()
+			<-copied
+			return buf.String()
+		}
+	}
+	readStdout, readStderr := capture(&os.Stdout), capture(&os.Stderr)
+
+	p := NewPrinterWithOptions(Options{Quiet: true, Timeout: time.Minute})
+	p.HandleEvent(council.Event{Type: council.EventPhaseStart, Phase: council.PhaseQuery, Count: 1})
+	p.HandleEvent(council.Event{Type: council.EventStarted, Phase: council.PhaseQuery, Member: "model-a", Model: "model-a"})
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-a", Model: "model-a", Content: "Answer"})
+	p.PrintFinalResult("The answer.")
+	stdout, stderr := readStdout(), readStderr()
+
+	if stdout != "The answer.\n" {
+		t.Errorf("stdout = %q, want only the answer", stdout)
+	}
+	for _, want := range []string{"Querying 1 models", "model-a"} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("expected progress %q on stderr, got %q", want, stderr)
+		}
+	}
+}
+
+func TestNoBanner(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out, noBanner: true}
+	p.PrintBanner()
+	p.PrintQuestion("What is 2+2?")
+	if out.Len() != 0 {
+		t.Errorf("expected no banner or question, got %q", out.String())
+	}
+
+	p.noBanner = false
+	p.PrintQuestion("What is 2+2?")
+	if !strings.Contains(out.String(), "What is 2+2?") {
+		t.Errorf("expected the question to be echoed, got %q", out.String())
+	}
+
+	if !NewPrinterWithOptions(Options{Quiet: true}).noBanner {
+		t.Error("quiet mode should imply no banner")
+	}
+}
+
+func TestPrintDetailedErrorShowsErrorDetail(t *testing.T) {
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-a": {{Error: "HTTP 429 Too Many Requests", RequestID: "C2A3:1B2E:8F0A12"}},
+	}}
+	_, _, err := copilot.NewMockClient(fixture, false).AskSingleModel(context.Background(), copilot.ModelMember("model-a"), "q", time.Minute)
+
+	var out bytes.Buffer
+	p := &Printer{out: &out}
+	p.PrintDetailedError("model-a", err, time.Second)
+	for _, want := range []string{"Status:     429", "Request ID: C2A3:1B2E:8F0A12"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in the error box, got:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	p.PrintDetailedError("model-a", errors.New("boom"), time.Second)
+	if strings.Contains(out.String(), "Request ID") {
+		t.Errorf("expected no request ID line without detail, got:\n%s", out.String())
+	}
+}
+
+func TestPrintModelResponseShowsErrorDetail(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out}
+	p.PrintModelResponse(failedResponse(t))
+	for _, want := range []string{"model-b", "Issue:      HTTP 429 Too Many Requests", "Status:     429", "Request ID: C2A3:1B2E:8F0A12"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in the failed response, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestProgressLabel(t *testing.T) {
+	tests := []struct {
+		event council.Event
+		want  string
+		ok    bool
+	}{
+		{council.Event{Phase: council.PhaseQuery, Member: "a"}, "a", true},
+		{council.Event{Phase: council.PhaseQuery, Member: "a", Attempt: 1}, "a (retry)", true},
+		{council.Event{Phase: council.PhaseReview, Member: "a"}, "a (review)", true},
+		{council.Event{Phase: council.PhaseRoundtable, Member: "a"}, "a (revised)", true},
+		{council.Event{Phase: council.PhaseCritique, Member: "a"}, "a (critique)", true},
+		{council.Event{Phase: council.PhaseAggregation, Member: "chairman"}, "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := progressLabel(tt.event); got != tt.want || ok != tt.ok {
+			t.Errorf("progressLabel(%s) = %q, %v; want %q, %v", tt.event.Phase, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestTruncateLines(t *testing.T) {
+	content := "one\ntwo\nthree\nfour\n"
+	if kept, cut := truncateLines(content, 2, 80); kept != "one\ntwo" || cut != 2 {
+		t.Errorf("truncateLines = %q, %d; want the first two lines and 2 cut", kept, cut)
+	}
+	if kept, cut := truncateLines(content, 10, 80); kept != "one\ntwo\nthree\nfour" || cut != 0 {
+		t.Errorf("expected short content to be kept whole, got %q, %d", kept, cut)
+	}
+
+	// A 250-column line wraps onto 4 rows at width 80
+	long := strings.Repeat("x", 250)
+	if kept, cut := truncateLines(long, 2, 80); len(kept) != 160 || cut != 2 {
+		t.Errorf("expected a long line cut to 2 rows, got %d columns and %d cut", len(kept), cut)
+	}
+}
+
+func TestPrintModelResponseMaxLines(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out, maxLines: 2}
+	p.PrintModelResponse(copilot.Response{Model: "model-a", Content: "one\ntwo\nthree\nfour\nfive"})
+
+	if strings.Contains(out.String(), "three") {
+		t.Errorf("expected the response cut after two lines, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "… (3 more lines; --verbose shows them, --also-markdown or --responses-dir saves them)") {
+		t.Errorf("expected a truncation note, got:\n%s", out.String())
+	}
+
+	out.Reset()
+	p.verbose = true
+	p.PrintModelResponse(copilot.Response{Model: "model-a", Content: "one\ntwo\nthree\nfour\nfive"})
+	if !strings.Contains(out.String(), "five") || strings.Contains(out.String(), "more lines") {
+		t.Errorf("expected verbose mode to print the full response, got:\n%s", out.String())
+	}
+}
+
+func TestPrintModelResponsePartial(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out}
+	p.PrintModelResponse(copilot.Response{Model: "model-a", Content: "Go is a compiled", Error: errors.New("stream reset"), Partial: true})
+
+	for _, want := range []string{"model-a (incomplete)", "Go is a compiled", "stream reset"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestHandleEventAnalyzesResponses(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{verbose: true, analyze: true, out: &out, noSpinner: true, spinners: make(map[string]*spinner.Spinner)}
+
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-a", Model: "model-a", Content: "This is a great and simple answer."})
+	if !strings.Contains(out.String(), "7 words · en · positive") {
+		t.Errorf("expected the analysis under the header, got %q", out.String())
+	}
+
+	out.Reset()
+	p.analyze = false
+	p.HandleEvent(council.Event{Type: council.EventFinished, Phase: council.PhaseQuery, Member: "model-a", Model: "model-a", Content: "This is a great and simple answer."})
+	if strings.Contains(out.String(), "words") {
+		t.Errorf("answers are only analyzed on request, got %q", out.String())
+	}
+}
+
+func TestPrintSummaryRetries(t *testing.T) {
+	client := copilot.NewMockClient(&copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"flaky":    {{Content: ""}, {Content: ""}, {Content: "Answer"}},
+		"chairman": {{Error: "prompt is too long for this model"}, {Content: "Synthesis"}},
+	}}, false)
+	c := council.NewCouncilWithClient(council.Config{
+		Models:     []string{"flaky", "steady"},
+		Aggregator: "chairman",
+		Timeout:    time.Minute,
+		RetryEmpty: 2,
+	}, client)
+	result := c.Execute(context.Background(), "What is Go?", nil, nil)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	if !strings.Contains(out.String(), "Retries:             flaky (2), aggregation (1)") {
+		t.Errorf("expected the retry counts in the summary, got:\n%s", out.String())
+	}
+
+	out.Reset()
+	(&Printer{out: &out}).PrintSummary(council.Result{ModelResponses: []copilot.Response{{Model: "steady", Content: "Answer"}}}, time.Second)
+	if strings.Contains(out.String(), "Retries:") {
+		t.Errorf("expected no retry line without retries, got:\n%s", out.String())
+	}
+}
+
+func TestPrintSummaryCouncilFavorite(t *testing.T) {
+	result := council.Result{
+		ModelResponses: []copilot.Response{{Model: "model-a", Content: "A"}, {Model: "model-b", Content: "B"}, {Model: "model-c", Content: "C"}},
+		Reviews: []council.Review{
+			{ReviewerModel: "model-a", Rankings: []council.Ranking{{Model: "model-b", Rank: 1}}},
+			{ReviewerModel: "model-b", Rankings: []council.Ranking{{Model: "model-a", Rank: 1}}},
+			{ReviewerModel: "model-c", Rankings: []council.Ranking{{Model: "model-b", Rank: 1}}},
+		},
+		Favorite:        "model-b",
+		FavoriteVotes:   2,
+		ClosestToAnswer: "model-a",
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	for _, want := range []string{"🏆 Council favorite: model-b (2/3 votes)", "Synthesis:         closest to model-a"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+
+	result.ClosestToAnswer = "model-b"
+	out.Reset()
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	if !strings.Contains(out.String(), "follows the favorite") {
+		t.Errorf("expected the synthesis to follow the favorite, got:\n%s", out.String())
+	}
+}
+
+func TestPrintSummaryTiedForFirst(t *testing.T) {
+	result := council.Result{
+		ModelResponses: []copilot.Response{{Model: "model-a", Content: "A"}, {Model: "model-b", Content: "B"}},
+		Reviews: []council.Review{
+			{ReviewerModel: "model-a", Rankings: []council.Ranking{{Model: "model-b", Rank: 1}}},
+			{ReviewerModel: "model-b", Rankings: []council.Ranking{{Model: "model-a", Rank: 1}}},
+		},
+		PluralityWinner: []string{"model-a", "model-b"},
+		PluralityVotes:  1,
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	if !strings.Contains(out.String(), "Tied for first:    model-a, model-b (1/2 each)") {
+		t.Errorf("expected the tied members in:\n%s", out.String())
+	}
+
+	// A single winner is already the council favorite
+	result.PluralityWinner, result.Favorite, result.FavoriteVotes = []string{"model-b"}, "model-b", 1
+	out.Reset()
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	if strings.Contains(out.String(), "Tied for first") {
+		t.Errorf("expected no tie line for a single winner, got:\n%s", out.String())
+	}
+}
+
+func TestPrintSummaryTiebreak(t *testing.T) {
+	result := council.Result{
+		ModelResponses: []copilot.Response{{Model: "model-a", Content: "A"}, {Model: "model-b", Content: "B"}},
+		Reviews:        []council.Review{{ReviewerModel: "model-a", Rankings: []council.Ranking{{Model: "model-b", Rank: 1}}}},
+		Consensus:      []council.ConsensusScore{{Model: "model-b", Score: 0.5}, {Model: "model-a", Score: 0.5}},
+		Tiebreak:       &council.Tiebreak{Model: "judge", Between: [2]string{"model-a", "model-b"}, Winner: "model-b"},
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	if !strings.Contains(out.String(), "Tiebreak:          model-b over model-a (judge)") {
+		t.Errorf("expected the tiebreaker's pick in:\n%s", out.String())
+	}
+}
+
+func TestPrintSummaryReviewSkipped(t *testing.T) {
+	result := council.Result{
+		ModelResponses: []copilot.Response{{Model: "model-a", Content: "Yes"}, {Model: "model-b", Content: "No"}},
+		ReviewSkipped:  "all answers under 20 characters",
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	if !strings.Contains(out.String(), "Stage 2: Peer Review") || !strings.Contains(out.String(), "Skipped:           all answers under 20 characters") {
+		t.Errorf("expected the skipped review in:\n%s", out.String())
+	}
+}
+
+func TestPrintSummaryFieldVote(t *testing.T) {
+	result := council.Result{
+		ModelResponses: []copilot.Response{{Model: "model-a", Content: "{}"}, {Model: "model-b", Content: "{}"}, {Model: "model-c", Content: "no"}},
+		FieldVotes: []council.FieldVote{
+			{Field: "answer", Value: []byte(`"yes"`), Votes: 2, Voters: 2},
+			{Field: "confidence", Value: []byte(`0.9`), Votes: 1, Voters: 2, Tied: true},
+		},
+		NonConforming: []council.SchemaViolation{{Member: "model-c", Reason: "not a JSON object"}},
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintSummary(result, time.Second)
+	for _, want := range []string{"Stage 3: Field Vote", "Conforming:        2/3 answers", "Non-conforming:    model-c", "Fields:            2 voted, 1 split"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "Final Synthesis") {
+		t.Errorf("expected no synthesis stage, got:\n%s", out.String())
+	}
+}
+
+func TestPrintClaimChecks(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out}
+	p.PrintClaimChecks(nil)
+	if out.Len() != 0 {
+		t.Errorf("expected nothing without checks, got:\n%s", out.String())
+	}
+
+	p.PrintClaimChecks([]council.ClaimCheck{
+		{Claim: "Go has goroutines.", Supported: true, Members: []string{"model-a"}},
+		{Claim: "Go was released in 2009."},
+	})
+	for _, want := range []string{"CLAIM CHECK", "1 of 2 claims are not supported", "✗ Go was released in 2009."} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "Go has goroutines") {
+		t.Errorf("supported claims should not be listed:\n%s", out.String())
+	}
+
+	out.Reset()
+	p.PrintClaimChecks([]council.ClaimCheck{{Claim: "Go has goroutines.", Supported: true}})
+	if !strings.Contains(out.String(), "All 1 claims are supported") {
+		t.Errorf("expected the all-supported line, got:\n%s", out.String())
+	}
+}
+
+func TestPrintQueryingStartSkipHint(t *testing.T) {
+	var out bytes.Buffer
+	p := &Printer{out: &out}
+	p.PrintQueryingStart()
+	if strings.Contains(out.String(), "Press s") {
+		t.Error("the skip hint should only show when the key is watched")
+	}
+
+	out.Reset()
+	p.SetSkipHint(true)
+	p.PrintQueryingStart()
+	if !strings.Contains(out.String(), "Press s to stop waiting") {
+		t.Errorf("expected the skip hint, got:\n%s", out.String())
+	}
+}
+
+func TestPrintGathered(t *testing.T) {
+	result := council.Result{
+		ModelResponses: []copilot.Response{
+			{Model: "model-a", Content: "Answer A"},
+			{Model: "model-b", Content: "Answer B"},
+			{Model: "model-c", Error: errors.New("timeout")},
+			{Model: "model-d", Content: "Answer D"},
+		},
+		Consensus: []council.ConsensusScore{{Model: "model-b", Score: 1, Reviews: 2}, {Model: "model-a", Score: 0, Reviews: 2}},
+	}
+
+	var out bytes.Buffer
+	(&Printer{out: &out}).PrintGathered(result)
+	got := out.String()
+	b, a, d := strings.Index(got, "Answer B"), strings.Index(got, "Answer A"), strings.Index(got, "Answer D")
+	if b < 0 || a < b || d < a {
+		t.Errorf("expected the answers best ranked first, unranked last:\n%s", got)
+	}
+	if strings.Contains(got, "model-c") {
+		t.Errorf("failed members should not be listed:\n%s", got)
+	}
+	if !strings.Contains(got, "1. model-b") || !strings.Contains(got, "1.00 (2 reviews)") {
+		t.Errorf("expected the peer review ranking:\n%s", got)
+	}
+
+	out.Reset()
+	(&Printer{answerOut: &out, quiet: true}).PrintGathered(result)
+	if want := "## model-b\n\nAnswer B\n\n## model-a\n\nAnswer A\n\n## model-d\n\nAnswer D"; out.String() != want {
+		t.Errorf("quiet output = %q, want %q", out.String(), want)
+	}
+}
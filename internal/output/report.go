@@ -0,0 +1,265 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+// Report is the structured rendering of a run, written by --also-json
+type Report struct {
+	Question   string           `json:"question"`
+	Answer     string           `json:"answer"`
+	Aggregator string           `json:"aggregator,omitempty"`
+	Responses  []ReportResponse `json:"responses"`
+	Critiques  []ReportResponse `json:"critiques,omitempty"` // Proposer mode only
+	Reviews    []ReportReview   `json:"reviews,omitempty"`
+	Consensus  []ReportScore    `json:"consensus,omitempty"`
+	Sources    []string         `json:"sources,omitempty"` // With --collect-sources
+	Stats      Footer           `json:"stats"`
+
+	// Requests per phase; Review and Critique only when the phase ran
+	Query       ReportPhase       `json:"query"`
+	Review      *ReportPhase      `json:"review,omitempty"`
+	Critique    *ReportPhase      `json:"critique,omitempty"`
+	Aggregation ReportAggregation `json:"aggregation"`
+
+	// With --min-distinct: viewpoints among the answers, and the models added to reach them
+	DistinctViewpoints int      `json:"distinct_viewpoints,omitempty"`
+	AddedMembers       []string `json:"added_members,omitempty"`
+
+	Tiebreak *ReportTiebreak `json:"tiebreak,omitempty"` // With --tiebreaker, when the top two were tied
+
+	ReviewSkipped string `json:"review_skipped,omitempty"` // With --min-review-length, why peer review did not run
+}
+
+// ReportTiebreak is the tiebreaker's decision between the two top responses
+type ReportTiebreak struct {
+	Model   string   `json:"model"`
+	Between []string `json:"between"`
+	Winner  string   `json:"winner,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// ReportPhase counts the requests of one phase
+type ReportPhase struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// ReportAggregation is the outcome of the final synthesis
+type ReportAggregation struct {
+	Succeeded bool `json:"succeeded"`
+}
+
+// ReportResponse is one member's answer
+type ReportResponse struct {
+	Member          string             `json:"member"`
+	Model           string             `json:"model"`
+	Content         string             `json:"content,omitempty"`
+	Error           string             `json:"error,omitempty"`
+	ErrorDetail     *ReportErrorDetail `json:"error_detail,omitempty"`
+	RequestID       string             `json:"request_id,omitempty"` // Provider request ID of a failed request
+	Succeeded       bool               `json:"succeeded"`
+	DurationSeconds float64            `json:"duration_seconds"`
+	Partial         bool               `json:"partial,omitempty"`
+	Refused         bool               `json:"refused,omitempty"`
+	Citations       []string           `json:"citations,omitempty"`
+	WordCount       int                `json:"word_count,omitempty"`
+	Language        string             `json:"language,omitempty"`
+	Sentiment       string             `json:"sentiment,omitempty"`
+}
+
+// ReportErrorDetail is what the provider reported about a failed request
+type ReportErrorDetail struct {
+	StatusCode int    `json:"status_code,omitempty"`
+	Code       string `json:"code,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// ReportReview is one member's peer review
+type ReportReview struct {
+	Reviewer string          `json:"reviewer"`
+	Rankings []ReportRanking `json:"rankings,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Skipped  bool            `json:"skipped,omitempty"`
+}
+
+// ReportRanking is the rank a reviewer gave one response
+type ReportRanking struct {
+	Member    string `json:"member"`
+	Rank      int    `json:"rank"`
+	Reasoning string `json:"reasoning,omitempty"`
+}
+
+// ReportScore is a member's peer review consensus
+type ReportScore struct {
+	Member  string  `json:"member"`
+	Score   float64 `json:"score"`
+	Reviews int     `json:"reviews"`
+}
+
+// NewReport collects everything worth keeping from a run
+func NewReport(question string, result council.Result, totalDuration time.Duration) Report {
+	stats := council.Stats(result)
+	report := Report{
+		Question:   question,
+		Answer:     result.AggregatedResponse,
+		Aggregator: result.AggregatorModel,
+		Responses:  make([]ReportResponse, 0, len(result.ModelResponses)),
+		Sources:    result.Sources,
+		Stats:      NewFooter(result, totalDuration),
+
+		DistinctViewpoints: result.DistinctViewpoints,
+		AddedMembers:       result.AddedMembers,
+		ReviewSkipped:      result.ReviewSkipped,
+
+		Query:       ReportPhase(stats.Query),
+		Aggregation: ReportAggregation{Succeeded: stats.AggregationSucceeded},
+	}
+	if stats.Review.Total > 0 {
+		review := ReportPhase(stats.Review)
+		report.Review = &review
+	}
+	if stats.Critique.Total > 0 {
+		critique := ReportPhase(stats.Critique)
+		report.Critique = &critique
+	}
+	if tb := result.Tiebreak; tb != nil {
+		report.Tiebreak = &ReportTiebreak{Model: tb.Model, Between: tb.Between[:], Winner: tb.Winner}
+		if tb.Error != nil {
+			report.Tiebreak.Error = tb.Error.Error()
+		}
+	}
+	for _, resp := range result.ModelResponses {
+		report.Responses = append(report.Responses, newReportResponse(resp))
+	}
+	for _, critique := range result.Critiques {
+		report.Critiques = append(report.Critiques, newReportResponse(critique))
+	}
+	for _, review := range result.Reviews {
+		r := ReportReview{Reviewer: review.ReviewerModel, Skipped: review.Skipped}
+		if review.Error != nil {
+			r.Error = review.Error.Error()
+		}
+		for _, ranking := range review.Rankings {
+			r.Rankings = append(r.Rankings, ReportRanking{Member: ranking.Model, Rank: ranking.Rank, Reasoning: ranking.Reasoning})
+		}
+		report.Reviews = append(report.Reviews, r)
+	}
+	for _, score := range result.Consensus {
+		report.Consensus = append(report.Consensus, ReportScore{Member: score.Model, Score: score.Score, Reviews: score.Reviews})
+	}
+	return report
+}
+
+// newReportResponse converts one member's response for a Report
+func newReportResponse(resp copilot.Response) ReportResponse {
+	r := ReportResponse{
+		Member:          resp.Label(),
+		Model:           resp.Model,
+		Content:         resp.Content,
+		Succeeded:       resp.Error == nil && resp.Content != "",
+		DurationSeconds: roundSeconds(resp.Duration),
+		Partial:         resp.Partial,
+		Refused:         resp.Refused,
+		Citations:       resp.Citations,
+		WordCount:       resp.WordCount,
+		Language:        resp.DetectedLanguage,
+		Sentiment:       resp.Sentiment,
+	}
+	if resp.Error != nil {
+		r.Error = resp.Error.Error()
+	}
+	detail := resp.ErrorDetail
+	if detail == nil {
+		detail = copilot.ErrorDetailOf(resp.Error)
+	}
+	if detail != nil {
+		r.RequestID = detail.RequestID
+		errDetail := ReportErrorDetail{StatusCode: detail.StatusCode, Code: detail.Code}
+		if detail.Message != r.Error {
+			errDetail.Message = detail.Message // The provider's own wording, when it differs from Error
+		}
+		if errDetail != (ReportErrorDetail{}) {
+			r.ErrorDetail = &errDetail
+		}
+	}
+	return r
+}
+
+// RenderJSON renders a run as indented JSON
+func RenderJSON(question string, result council.Result, totalDuration time.Duration) ([]byte, error) {
+	data, err := json.MarshalIndent(NewReport(question, result, totalDuration), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// RenderMarkdown renders a run as a Markdown document: the answer first,
+// then every member's response and the peer review consensus
+func RenderMarkdown(question string, result council.Result, totalDuration time.Duration) string {
+	var sb strings.Builder
+	sb.WriteString("# Council Answer\n\n")
+	sb.WriteString(fmt.Sprintf("**Question:** %s\n\n", question))
+	sb.WriteString(strings.TrimSpace(result.AggregatedResponse))
+	sb.WriteString("\n\n")
+
+	sb.WriteString("## Member Responses\n")
+	for _, resp := range result.ModelResponses {
+		label := resp.Label()
+		if label != resp.Model {
+			label = fmt.Sprintf("%s (%s)", label, resp.Model)
+		}
+		switch {
+		case resp.Partial:
+			label += " (incomplete)"
+		case resp.Refused:
+			label += " (refused)"
+		}
+		sb.WriteString(fmt.Sprintf("\n### %s, %.2fs\n\n", label, resp.Duration.Seconds()))
+		if resp.Content != "" {
+			sb.WriteString(strings.TrimSpace(resp.Content))
+			sb.WriteString("\n")
+		}
+		if resp.Error != nil {
+			sb.WriteString(fmt.Sprintf("\n_Error: %v_\n", resp.Error))
+		}
+	}
+
+	if len(result.Critiques) > 0 {
+		sb.WriteString("\n## Critiques\n")
+		for _, critique := range result.Critiques {
+			sb.WriteString(fmt.Sprintf("\n### %s, %.2fs\n\n", critique.Label(), critique.Duration.Seconds()))
+			if critique.Error != nil {
+				sb.WriteString(fmt.Sprintf("_Error: %v_\n", critique.Error))
+			} else {
+				sb.WriteString(strings.TrimSpace(critique.Content))
+				sb.WriteString("\n")
+			}
+		}
+	}
+
+	if len(result.Consensus) > 0 {
+		sb.WriteString("\n## Peer Review Consensus\n\n")
+		sb.WriteString("| Rank | Member | Score | Reviews |\n")
+		sb.WriteString("|------|--------|-------|---------|\n")
+		for i, score := range result.Consensus {
+			sb.WriteString(fmt.Sprintf("| %d | %s | %.2f | %d |\n", i+1, score.Model, score.Score, score.Reviews))
+		}
+	}
+
+	stats := NewFooter(result, totalDuration)
+	sb.WriteString(fmt.Sprintf("\n---\n\n%d/%d members answered in %.2fs", stats.Succeeded, stats.Models, stats.TotalSeconds))
+	if result.AggregatorModel != "" {
+		sb.WriteString(fmt.Sprintf("; synthesized by %s", result.AggregatorModel))
+	}
+	sb.WriteString(".\n")
+	return sb.String()
+}
@@ -0,0 +1,88 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func reportResult() council.Result {
+	return council.Result{
+		ModelResponses: []copilot.Response{
+			{Model: "model-a", Content: "Answer A", Duration: time.Second},
+			{Model: "model-b", Error: errors.New("timeout"), Duration: 2 * time.Second},
+		},
+		Reviews: []council.Review{{
+			ReviewerModel: "model-a",
+			Rankings:      []council.Ranking{{Model: "model-b", Rank: 1, Reasoning: "Clear"}},
+		}},
+		Consensus:          []council.ConsensusScore{{Model: "model-a", Score: 1, Reviews: 1}},
+		AggregatorModel:    "chairman",
+		AggregatedResponse: "The synthesis",
+	}
+}
+
+// failedResponse is a mock member's answer that failed with a status code and request ID
+func failedResponse(t *testing.T) copilot.Response {
+	t.Helper()
+	fixture := &copilot.MockFixture{Models: map[string][]copilot.MockResponse{
+		"model-b": {{Error: "HTTP 429 Too Many Requests", RequestID: "C2A3:1B2E:8F0A12"}},
+	}}
+	responses := copilot.NewMockClient(fixture, false).AskMultipleModels(context.Background(), []copilot.Member{copilot.ModelMember("model-b")}, "q", time.Minute, nil)
+	return responses[0]
+}
+
+func TestRenderJSON(t *testing.T) {
+	data, err := RenderJSON("What is Go?", reportResult(), 3*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("report is not valid JSON: %v", err)
+	}
+	if report.Question != "What is Go?" || report.Answer != "The synthesis" || report.Aggregator != "chairman" {
+		t.Errorf("unexpected report header: %+v", report)
+	}
+	if len(report.Responses) != 2 || report.Responses[1].Error != "timeout" {
+		t.Errorf("unexpected responses: %+v", report.Responses)
+	}
+	if len(report.Reviews) != 1 || report.Reviews[0].Rankings[0].Member != "model-b" {
+		t.Errorf("unexpected reviews: %+v", report.Reviews)
+	}
+	if report.Stats.Succeeded != 1 || report.Stats.TotalSeconds != 3 {
+		t.Errorf("unexpected stats: %+v", report.Stats)
+	}
+	if report.Query != (ReportPhase{Total: 2, Succeeded: 1, Failed: 1}) || report.Review == nil || *report.Review != (ReportPhase{Total: 1, Succeeded: 1}) {
+		t.Errorf("unexpected phase counts: query %+v, review %+v", report.Query, report.Review)
+	}
+	if report.Critique != nil || !report.Aggregation.Succeeded {
+		t.Errorf("expected no critique counts and a successful aggregation, got %+v, %+v", report.Critique, report.Aggregation)
+	}
+	if !report.Responses[0].Succeeded || report.Responses[1].Succeeded {
+		t.Errorf("unexpected per-member success: %+v", report.Responses)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	markdown := RenderMarkdown("What is Go?", reportResult(), 3*time.Second)
+	for _, want := range []string{
+		"**Question:** What is Go?",
+		"The synthesis",
+		"### model-a, 1.00s\n\nAnswer A",
+		"_Error: timeout_",
+		"| 1 | model-a | 1.00 | 1 |",
+		"1/2 members answered in 3.00s; synthesized by chairman.",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("expected %q in:\n%s", want, markdown)
+		}
+	}
+}
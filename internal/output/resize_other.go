@@ -0,0 +1,7 @@
+//go:build !linux && !darwin
+
+package output
+
+// watchResize is only implemented for Linux and macOS terminals; elsewhere
+// the size detected at startup is kept
+func watchResize(onResize func()) {}
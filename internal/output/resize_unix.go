@@ -0,0 +1,20 @@
+//go:build linux || darwin
+
+package output
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// watchResize calls onResize whenever the terminal is resized (SIGWINCH)
+func watchResize(onResize func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGWINCH)
+	go func() {
+		for range signals {
+			onResize()
+		}
+	}()
+}
@@ -0,0 +1,175 @@
+package output
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ReportSchema is the JSON Schema that documents written by --also-json
+// follow
+//
+//go:embed report.schema.json
+var ReportSchema []byte
+
+// schema is the subset of JSON Schema that ReportSchema uses: type,
+// required, properties, additionalProperties, items, minimum and local $refs
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 schemaTypes        `json:"type"`
+	Required             []string           `json:"required"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	Minimum              *float64           `json:"minimum"`
+	Defs                 map[string]*schema `json:"$defs"`
+}
+
+// schemaTypes accepts both "type": "string" and "type": ["number", "null"]
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = schemaTypes{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// ValidateReport checks a --also-json document against ReportSchema and
+// describes the first violation found
+func ValidateReport(data []byte) error {
+	var root schema
+	if err := json.Unmarshal(ReportSchema, &root); err != nil {
+		return fmt.Errorf("invalid report schema: %w", err)
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("report is not valid JSON: %w", err)
+	}
+	if err := root.validate(&root, doc, "$"); err != nil {
+		return fmt.Errorf("report does not match its schema: %w", err)
+	}
+	return nil
+}
+
+// validate checks value at path against s, resolving $refs in root
+func (s *schema) validate(root *schema, value interface{}, path string) error {
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/$defs/")
+		def, ok := root.Defs[name]
+		if !ok {
+			return fmt.Errorf("%s: unknown schema reference %s", path, s.Ref)
+		}
+		return def.validate(root, value, path)
+	}
+
+	if len(s.Type) > 0 && !s.Type.matches(value) {
+		return fmt.Errorf("%s: expected %s", path, strings.Join(s.Type, " or "))
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			return fmt.Errorf("%s: %v is below the minimum %v", path, v, *s.Minimum)
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, item := range v {
+				if err := s.Items.validate(root, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		return s.validateObject(root, v, path)
+	}
+	return nil
+}
+
+// validateObject checks the required, declared and additional properties of an object
+func (s *schema) validateObject(root *schema, object map[string]interface{}, path string) error {
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			return fmt.Errorf("%s: missing required property %q", path, name)
+		}
+	}
+
+	// Walk the keys in order so the reported violation is stable
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var additional *schema
+	allowAdditional := true
+	if len(s.AdditionalProperties) > 0 {
+		if err := json.Unmarshal(s.AdditionalProperties, &allowAdditional); err != nil {
+			additional = &schema{}
+			if err := json.Unmarshal(s.AdditionalProperties, additional); err != nil {
+				return fmt.Errorf("%s: invalid additionalProperties: %w", path, err)
+			}
+		}
+	}
+
+	for _, key := range keys {
+		propertyPath := path + "." + key
+		if property, ok := s.Properties[key]; ok {
+			if err := property.validate(root, object[key], propertyPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if additional != nil {
+			if err := additional.validate(root, object[key], propertyPath); err != nil {
+				return err
+			}
+		} else if !allowAdditional {
+			return fmt.Errorf("%s: unexpected property", propertyPath)
+		}
+	}
+	return nil
+}
+
+// matches reports whether a decoded JSON value has one of the types
+func (t schemaTypes) matches(value interface{}) bool {
+	for _, name := range t {
+		switch v := value.(type) {
+		case nil:
+			if name == "null" {
+				return true
+			}
+		case bool:
+			if name == "boolean" {
+				return true
+			}
+		case string:
+			if name == "string" {
+				return true
+			}
+		case float64:
+			if name == "number" || (name == "integer" && v == math.Trunc(v)) {
+				return true
+			}
+		case []interface{}:
+			if name == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if name == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openjny/council/internal/copilot"
+	"github.com/openjny/council/internal/council"
+)
+
+func TestRenderJSONMatchesSchema(t *testing.T) {
+	result := reportResult()
+	result.Critiques = []copilot.Response{{Model: "model-b", Content: "Critique", Partial: true, Refused: true}}
+	result.Reviews = append(result.Reviews, council.Review{ReviewerModel: "model-b", Skipped: true})
+	result.ReviewDuration = time.Second
+	result.ModelResponses[0].Citations = []string{"https://go.dev/doc/"}
+	result.ModelResponses[0] = council.AnalyzeResponse(result.ModelResponses[0])
+	result.Sources = []string{"https://go.dev/doc/"}
+	result.ModelResponses[1] = failedResponse(t)
+
+	data, err := RenderJSON("What is Go?", result, 3*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ValidateReport(data); err != nil {
+		t.Errorf("rendered report does not match the schema: %v", err)
+	}
+	for _, want := range []string{`"request_id": "C2A3:1B2E:8F0A12"`, `"status_code": 429`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s in the failed response, got:\n%s", want, data)
+		}
+	}
+
+	// Runs without peer review have a null consensus score
+	data, err = RenderJSON("What is Go?", council.Result{AggregatedResponse: "Answer", ReviewSkipped: "all answers under 20 characters"}, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ValidateReport(data); err != nil {
+		t.Errorf("minimal report does not match the schema: %v", err)
+	}
+}
+
+func TestValidateReport(t *testing.T) {
+	stats := `"stats": {"models": 1, "succeeded": 1, "phase_seconds": {"query": 1.5}, "total_seconds": 2, "consensus_score": null, "answer_chars": 6}`
+	for _, tt := range []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"valid", `{"question": "q", "answer": "Answer", "responses": [], ` + stats + `}`, ""},
+		{"not JSON", `{"question"`, "not valid JSON"},
+		{"missing property", `{"question": "q", "responses": [], ` + stats + `}`, `missing required property "answer"`},
+		{"wrong type", `{"question": "q", "answer": 42, "responses": [], ` + stats + `}`, "$.answer: expected string"},
+		{"unknown property", `{"question": "q", "answer": "a", "extra": true, "responses": [], ` + stats + `}`, "$.extra: unexpected property"},
+		{"bad item", `{"question": "q", "answer": "a", "responses": [{"member": "m", "model": "m", "duration_seconds": -1}], ` + stats + `}`, "$.responses[0].duration_seconds: -1 is below the minimum 0"},
+		{"fractional rank", `{"question": "q", "answer": "a", "responses": [], "reviews": [{"reviewer": "m", "rankings": [{"member": "m", "rank": 1.5}]}], ` + stats + `}`, "expected integer"},
+		{"bad phase time", `{"question": "q", "answer": "a", "responses": [], "stats": {"models": 1, "succeeded": 1, "phase_seconds": {"query": "slow"}, "total_seconds": 2, "consensus_score": null, "answer_chars": 6}}`, "$.stats.phase_seconds.query: expected number"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReport([]byte(tt.doc))
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package output
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/term"
+)
+
+// defaultWidth is the width assumed when stdout is not a terminal or its
+// size cannot be detected
+const defaultWidth = 80
+
+// terminal caches the size of the terminal on stdout. It is detected on first
+// use and again whenever the terminal is resized.
+var terminal struct {
+	once   sync.Once
+	width  atomic.Int64
+	height atomic.Int64
+}
+
+// terminalWidth returns the width of the terminal on stdout in columns, or
+// defaultWidth when it cannot be detected
+func terminalWidth() int {
+	terminal.once.Do(startTerminalSize)
+	return int(terminal.width.Load())
+}
+
+// terminalHeight returns the height of the terminal on stdout in lines, or 0
+// when it cannot be detected
+func terminalHeight() int {
+	terminal.once.Do(startTerminalSize)
+	return int(terminal.height.Load())
+}
+
+// startTerminalSize detects the terminal size and keeps it current
+func startTerminalSize() {
+	detectTerminalSize()
+	watchResize(detectTerminalSize)
+}
+
+// detectTerminalSize stores the current size of the terminal on stdout
+func detectTerminalSize() {
+	width, height := safeSize(term.GetSize(int(os.Stdout.Fd())))
+	terminal.width.Store(int64(width))
+	terminal.height.Store(int64(height))
+}
+
+// safeSize replaces a size that failed to be detected, or that makes no
+// sense, with defaultWidth columns and an unknown (0) height
+func safeSize(width, height int, err error) (int, int) {
+	if err != nil || width <= 0 {
+		width = defaultWidth
+	}
+	if err != nil || height < 0 {
+		height = 0
+	}
+	return width, height
+}
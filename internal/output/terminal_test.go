@@ -0,0 +1,38 @@
+package output
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSafeSize(t *testing.T) {
+	tests := []struct {
+		name                  string
+		width, height         int
+		err                   error
+		wantWidth, wantHeight int
+	}{
+		{name: "detected", width: 120, height: 40, wantWidth: 120, wantHeight: 40},
+		{name: "detection failed", width: 120, height: 40, err: errors.New("inappropriate ioctl for device"), wantWidth: 80},
+		{name: "zero size", wantWidth: 80},
+		{name: "negative size", width: -1, height: -1, wantWidth: 80},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := safeSize(tt.width, tt.height, tt.err)
+			if width != tt.wantWidth || height != tt.wantHeight {
+				t.Errorf("safeSize() = %d, %d; want %d, %d", width, height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestTruncateLinesTinyWidths(t *testing.T) {
+	content := "a line that is much wider than the terminal\n日本語の行\n\nend"
+	for _, width := range []int{-1, 0, 1, 2, 3} {
+		kept, cut := truncateLines(content, 3, width)
+		if kept == "" || cut <= 0 {
+			t.Errorf("width %d: truncateLines = %q, %d; want some kept and some cut", width, kept, cut)
+		}
+	}
+}
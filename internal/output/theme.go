@@ -0,0 +1,126 @@
+package output
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Color themes selectable with ApplyTheme
+const (
+	ThemeDark  = "dark"
+	ThemeLight = "light"
+	ThemeMono  = "mono"
+)
+
+// Themes lists the available themes, default first
+var Themes = []string{ThemeDark, ThemeLight, ThemeMono}
+
+// palette is the full set of colors the printer uses
+type palette struct {
+	title, model, err, success, dim, warning *color.Color
+	// members tell council members apart; see memberColor
+	members []*color.Color
+}
+
+var palettes = map[string]palette{
+	// Bright colors for dark terminal backgrounds (the default)
+	ThemeDark: {
+		title:   color.New(color.FgCyan, color.Bold),
+		model:   color.New(color.FgGreen, color.Bold),
+		err:     color.New(color.FgRed),
+		success: color.New(color.FgGreen),
+		dim:     color.New(color.Faint),
+		warning: color.New(color.FgYellow),
+		members: []*color.Color{
+			color.New(color.FgGreen, color.Bold),
+			color.New(color.FgCyan, color.Bold),
+			color.New(color.FgMagenta, color.Bold),
+			color.New(color.FgBlue, color.Bold),
+			color.New(color.FgHiYellow, color.Bold),
+			color.New(color.FgHiGreen, color.Bold),
+			color.New(color.FgHiCyan, color.Bold),
+			color.New(color.FgHiMagenta, color.Bold),
+		},
+	},
+	// Darker hues that stay legible on light backgrounds; no faint or yellow text
+	ThemeLight: {
+		title:   color.New(color.FgBlue, color.Bold),
+		model:   color.New(color.FgMagenta, color.Bold),
+		err:     color.New(color.FgRed, color.Bold),
+		success: color.New(color.FgGreen, color.Bold),
+		dim:     color.New(color.FgHiBlack),
+		warning: color.New(color.FgMagenta),
+		members: []*color.Color{
+			color.New(color.FgMagenta, color.Bold),
+			color.New(color.FgBlue, color.Bold),
+			color.New(color.FgGreen, color.Bold),
+			color.New(color.FgCyan, color.Bold),
+			color.New(color.FgRed),
+			color.New(color.FgBlack, color.Bold),
+		},
+	},
+	// No color at all
+	ThemeMono: {
+		title:   color.New(),
+		model:   color.New(),
+		err:     color.New(),
+		success: color.New(),
+		dim:     color.New(),
+		warning: color.New(),
+		members: []*color.Color{color.New()},
+	},
+}
+
+// Colors, reassigned together by ApplyTheme
+var (
+	titleColor   = palettes[ThemeDark].title
+	modelColor   = palettes[ThemeDark].model
+	errorColor   = palettes[ThemeDark].err
+	successColor = palettes[ThemeDark].success
+	dimColor     = palettes[ThemeDark].dim
+	warningColor = palettes[ThemeDark].warning
+	memberColors = palettes[ThemeDark].members
+)
+
+// ApplyTheme switches every printer color to the named theme.
+// The mono theme also disables color output, like --no-color.
+func ApplyTheme(name string) error {
+	p, ok := palettes[name]
+	if !ok {
+		return fmt.Errorf("unknown theme %q: expected one of %s", name, strings.Join(Themes, ", "))
+	}
+
+	titleColor = p.title
+	modelColor = p.model
+	errorColor = p.err
+	successColor = p.success
+	dimColor = p.dim
+	warningColor = p.warning
+	memberColors = p.members
+
+	if name == ThemeMono {
+		color.NoColor = true
+	}
+	return nil
+}
+
+// memberColor returns the color of a council member or model. The name is
+// hashed into the theme's member palette, so a name always gets the same color.
+func memberColor(name string) *color.Color {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return memberColors[h.Sum32()%uint32(len(memberColors))]
+}
+
+// memberValue pads a summary value to width, then colors the member name it
+// starts with. Padding first keeps the escape codes out of the alignment.
+func memberValue(value, name string, width int) string {
+	padded := fmt.Sprintf("%-*s", width, truncate(value, width))
+	if name == "" || !strings.HasPrefix(padded, name) {
+		return padded
+	}
+	return memberColor(name).Sprint(name) + padded[len(name):]
+}
@@ -0,0 +1,72 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestApplyTheme(t *testing.T) {
+	noColor := color.NoColor
+	t.Cleanup(func() {
+		_ = ApplyTheme(ThemeDark)
+		color.NoColor = noColor
+	})
+
+	if err := ApplyTheme(ThemeLight); err != nil {
+		t.Fatal(err)
+	}
+	if !titleColor.Equals(color.New(color.FgBlue, color.Bold)) || !dimColor.Equals(color.New(color.FgHiBlack)) {
+		t.Error("light theme should reassign every color")
+	}
+
+	if err := ApplyTheme(ThemeMono); err != nil {
+		t.Fatal(err)
+	}
+	if !color.NoColor {
+		t.Error("mono theme should disable color output")
+	}
+
+	if err := ApplyTheme("solarized"); err == nil {
+		t.Error("expected error for an unknown theme")
+	}
+}
+
+func TestMemberColor(t *testing.T) {
+	t.Cleanup(func() { _ = ApplyTheme(ThemeDark) })
+
+	if memberColor("gpt-5.2") != memberColor("gpt-5.2") {
+		t.Error("the same member should always get the same color")
+	}
+	used := make(map[*color.Color]bool)
+	for _, name := range []string{"claude-sonnet-4.5", "gpt-5.2", "gemini-3-pro-preview", "gpt-4.1", "o3"} {
+		used[memberColor(name)] = true
+	}
+	if len(used) < 2 {
+		t.Error("expected different members to get different colors")
+	}
+
+	if err := ApplyTheme(ThemeMono); err != nil {
+		t.Fatal(err)
+	}
+	if !memberColor("gpt-5.2").Equals(color.New()) {
+		t.Error("mono theme should not color members")
+	}
+}
+
+func TestMemberValueKeepsAlignment(t *testing.T) {
+	noColor := color.NoColor
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	color.NoColor = false
+	got := memberValue("gpt-5.2 (1.20s)", "gpt-5.2", 20)
+	if !strings.HasPrefix(got, "\x1b[") || !strings.HasSuffix(got, " (1.20s)     ") {
+		t.Errorf("expected a colored name padded to width, got %q", got)
+	}
+
+	color.NoColor = true
+	if got := memberValue("gpt-5.2 (1.20s)", "gpt-5.2", 20); got != "gpt-5.2 (1.20s)     " {
+		t.Errorf("expected plain padding without color, got %q", got)
+	}
+}